@@ -9,4 +9,18 @@ const (
 
 type BaseRules struct {
 	Required bool `json:"required" yaml:"required"`
+	// RequiredIf/RequiredUnless/RequiredWith/ExcludedWith are resolved by
+	// Schema against sibling fields - not config-loadable, since Cond
+	// carries an arbitrary Eq value - and have no effect on a field
+	// validated directly via Validate.
+	RequiredIf     []Cond   `json:"-" yaml:"-"`
+	RequiredUnless []Cond   `json:"-" yaml:"-"`
+	RequiredWith   []string `json:"-" yaml:"-"`
+	ExcludedWith   []string `json:"-" yaml:"-"`
+}
+
+// conditions implements conditionalRules, promoted onto every rule type
+// that embeds BaseRules.
+func (b BaseRules) conditions() (requiredIf, requiredUnless []Cond, requiredWith, excludedWith []string) {
+	return b.RequiredIf, b.RequiredUnless, b.RequiredWith, b.ExcludedWith
 }
@@ -0,0 +1,73 @@
+// Package build provides a generics-based fluent API for declaring sval
+// rules in Go, as an alternative to a YAML/JSON ValidatorConfig. It composes
+// with the existing RuleSet machinery - Rule[T].Build() returns a
+// sval.RuleSet that works with validator.AddRule exactly like a rule parsed
+// from config - and additionally allows user-supplied predicate functions
+// (Custom), which the map-based config format has no way to express.
+package build
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/0x0FACED/sval"
+)
+
+// Rule is an immutable pipeline of checks for values of type T. Every
+// chaining method returns a new *Rule[T] rather than mutating the receiver,
+// so a pipeline can be built up once and reused (or extended down two
+// different branches) without the branches affecting each other.
+type Rule[T any] struct {
+	checks []func(T) error
+}
+
+// New starts an empty pipeline for T.
+func New[T any]() *Rule[T] {
+	return &Rule[T]{}
+}
+
+// Custom appends a user-supplied predicate to the pipeline. This is the
+// escape hatch a map-based ValidatorConfig can't express: any Go function
+// can gate the value, not just the rule types createRuleSet knows about.
+func (r *Rule[T]) Custom(fn func(T) error) *Rule[T] {
+	return &Rule[T]{checks: append(slices.Clone(r.checks), fn)}
+}
+
+// Build turns the pipeline into a sval.RuleSet that runs every check in
+// order, returning the first failure - the same fail-fast behavior
+// StringRules/IntRules use for their own structural checks.
+func (r *Rule[T]) Build() sval.RuleSet {
+	return &builtRule[T]{checks: r.checks}
+}
+
+// builtRule adapts a Rule[T]'s checks to sval.RuleSet, doing the any->T
+// type assertion validateRecursive's untyped field values need.
+type builtRule[T any] struct {
+	checks []func(T) error
+}
+
+func (b *builtRule[T]) Validate(i any) error {
+	val, ok := i.(T)
+	if !ok {
+		err := sval.NewValidationError()
+		err.AddError("type", fmt.Sprintf("%T", *new(T)), i, "value is not of the expected type")
+		return err
+	}
+
+	for _, check := range b.checks {
+		if cErr := check(val); cErr != nil {
+			// A check that already produced a *sval.ValidationError (e.g.
+			// StringRule's MinLen/Matches/OneOf, which use the same rule
+			// names and Reason codes as string_rules.go) is returned as-is,
+			// so it keeps its Reason and translates like any other rule.
+			if ve, ok := cErr.(*sval.ValidationError); ok {
+				return ve
+			}
+			err := sval.NewValidationError()
+			err.AddError("custom", nil, i, cErr.Error())
+			return err
+		}
+	}
+
+	return nil
+}
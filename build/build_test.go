@@ -0,0 +1,72 @@
+package build
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRule_Custom(t *testing.T) {
+	rule := New[int]().Custom(func(v int) error {
+		if v < 0 {
+			return assert.AnError
+		}
+		return nil
+	}).Build()
+
+	assert.NoError(t, rule.Validate(5))
+	assert.Error(t, rule.Validate(-1))
+	assert.Error(t, rule.Validate("not an int"))
+}
+
+func TestRule_Immutable(t *testing.T) {
+	base := New[string]().Custom(func(v string) error { return nil })
+	withExtra := base.Custom(func(v string) error { return assert.AnError })
+
+	assert.NoError(t, base.Build().Validate("x"))
+	assert.Error(t, withExtra.Build().Validate("x"))
+}
+
+func TestStringRule(t *testing.T) {
+	rule := String().MinLen(3).MaxLen(8).Matches(regexp.MustCompile(`^[a-z]+$`)).Build()
+
+	assert.NoError(t, rule.Validate("hello"))
+	assert.Error(t, rule.Validate("hi"))
+	assert.Error(t, rule.Validate("waytoolongforthis"))
+	assert.Error(t, rule.Validate("Hello"))
+}
+
+func TestStringRule_OneOf(t *testing.T) {
+	rule := String().OneOf("admin", "user", "guest").Build()
+
+	assert.NoError(t, rule.Validate("admin"))
+	assert.Error(t, rule.Validate("superadmin"))
+}
+
+func TestStruct_FieldImmutable(t *testing.T) {
+	base := Struct[testUser]().
+		Field(For("name", func(u testUser) string { return u.Name }, String().MinLen(2)))
+
+	withEmail := base.Field(For("email", func(u testUser) string { return u.Email }, String().Matches(regexp.MustCompile(`^[^@]+@[^@]+$`))))
+
+	// base never saw the email rule, so an invalid email doesn't affect it.
+	assert.NoError(t, base.Validate(testUser{Name: "Alice", Email: "not-an-email"}))
+	assert.Error(t, withEmail.Validate(testUser{Name: "Alice", Email: "not-an-email"}))
+}
+
+type testUser struct {
+	Name  string
+	Email string
+}
+
+func TestStruct_Field(t *testing.T) {
+	validator := Struct[testUser]().
+		Field(For("name", func(u testUser) string { return u.Name }, String().MinLen(2))).
+		Field(For("email", func(u testUser) string { return u.Email }, String().Matches(regexp.MustCompile(`^[^@]+@[^@]+$`))))
+
+	assert.NoError(t, validator.Validate(testUser{Name: "Alice", Email: "alice@example.com"}))
+
+	err := validator.Validate(testUser{Name: "A", Email: "not-an-email"})
+	assert.Error(t, err)
+}
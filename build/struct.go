@@ -0,0 +1,111 @@
+package build
+
+import (
+	"slices"
+
+	"github.com/0x0FACED/sval"
+)
+
+// FieldValidator is a single field's pipeline bound to S, produced by For.
+// StructBuilder[S] collects these, so it type-erases the field's own value
+// type T behind Validate's S->error signature.
+type FieldValidator[S any] interface {
+	Path() string
+	Validate(s S) error
+}
+
+// Builder produces a sval.RuleSet from a pipeline of checks against T. Both
+// *Rule[T] and *StringRule (via its embedded *Rule[string]) satisfy this,
+// so For accepts either directly.
+type Builder[T any] interface {
+	Build() sval.RuleSet
+}
+
+// fieldRule binds a Builder[T] to a path within S via a getter function -
+// the wiring Struct[S]().Field needs to validate struct fields without
+// requiring S's fields to carry "sval" struct tags.
+type fieldRule[S any, T any] struct {
+	path   string
+	getter func(S) T
+	rule   Builder[T]
+}
+
+// For declares a field rule: path is the dotted key used when reporting
+// errors (mirroring the "sval" tag convention elsewhere in this package),
+// getter extracts the field value from S, and rule is the pipeline to run
+// against it.
+func For[S any, T any](path string, getter func(S) T, rule Builder[T]) FieldValidator[S] {
+	return &fieldRule[S, T]{path: path, getter: getter, rule: rule}
+}
+
+func (f *fieldRule[S, T]) Path() string {
+	return f.path
+}
+
+func (f *fieldRule[S, T]) Validate(s S) error {
+	return f.rule.Build().Validate(f.getter(s))
+}
+
+// StructBuilder collects FieldValidators for S. It lets callers validate S
+// without declaring "sval" struct tags or a ValidatorConfig at all.
+type StructBuilder[S any] struct {
+	fields []FieldValidator[S]
+}
+
+// Struct starts an empty field list for S.
+func Struct[S any]() *StructBuilder[S] {
+	return &StructBuilder[S]{}
+}
+
+// Field returns a new builder with fv added, leaving b itself untouched -
+// the same immutable-pipeline guarantee Rule[T].Custom makes, so branching
+// from a shared base builder doesn't let one branch's fields leak into
+// another's: base := Struct[User](); a := base.Field(x); b := base.Field(y)
+// leaves a and b with independent field lists.
+func (b *StructBuilder[S]) Field(fv FieldValidator[S]) *StructBuilder[S] {
+	return &StructBuilder[S]{fields: append(slices.Clone(b.fields), fv)}
+}
+
+// Validate runs every registered field's pipeline against s, aggregating
+// failures into a single *sval.ValidationError the same way
+// validator.Validate does for tag-driven structs.
+func (b *StructBuilder[S]) Validate(s S) error {
+	errs := sval.NewValidationError()
+
+	for _, fv := range b.fields {
+		if err := fv.Validate(s); err != nil {
+			ve, ok := err.(*sval.ValidationError)
+			if !ok {
+				ve = sval.NewValidationError()
+				ve.AddError("", nil, nil, err.Error())
+			}
+			ve.AddContextToErrors(fv.Path())
+			errs.AppendError(ve)
+		}
+	}
+
+	if errs.HasErrors() {
+		return errs
+	}
+	return nil
+}
+
+// Build adapts the struct pipeline to sval.RuleSet, so it can be nested
+// under a field of some larger struct via validator.AddRule too.
+func (b *StructBuilder[S]) Build() sval.RuleSet {
+	return &builtStruct[S]{builder: b}
+}
+
+type builtStruct[S any] struct {
+	builder *StructBuilder[S]
+}
+
+func (b *builtStruct[S]) Validate(i any) error {
+	val, ok := i.(S)
+	if !ok {
+		err := sval.NewValidationError()
+		err.AddError("type", nil, i, "value is not of the expected struct type")
+		return err
+	}
+	return b.builder.Validate(val)
+}
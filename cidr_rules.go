@@ -0,0 +1,123 @@
+package sval
+
+import (
+	"net"
+	"net/netip"
+)
+
+type CIDRRuleName = string
+
+const (
+	CIDRRuleNameMinPrefixLen    CIDRRuleName = "min_prefix_len"
+	CIDRRuleNameMaxPrefixLen    CIDRRuleName = "max_prefix_len"
+	CIDRRuleNameRequireCanon    CIDRRuleName = "require_canonical"
+	CIDRRuleNameRequireHostZero CIDRRuleName = "require_host_bits_zero"
+)
+
+// CIDRRules validates CIDR notation (e.g. "192.168.1.0/24"), alongside the
+// adjacent IPRules and MACRules network-primitive validators.
+type CIDRRules struct {
+	BaseRules
+	MinPrefixLen *int `json:"min_prefix_len,omitempty" yaml:"min_prefix_len"`
+	MaxPrefixLen *int `json:"max_prefix_len,omitempty" yaml:"max_prefix_len"`
+	// RequireCanonical rejects input whose string form isn't already what
+	// netip.Prefix.String() would produce (e.g. a non-minimal IPv6 address).
+	RequireCanonical bool `json:"require_canonical" yaml:"require_canonical"`
+	// RequireHostBitsZero rejects input like "192.168.1.5/24" whose address
+	// part isn't the network address, i.e. has nonzero host bits.
+	RequireHostBitsZero bool `json:"require_host_bits_zero" yaml:"require_host_bits_zero"`
+}
+
+func (r *CIDRRules) Validate(i any) error {
+	err := NewValidationError()
+
+	if i == nil {
+		if r.Required {
+			err.AddError(BaseRuleNameRequired, r.Required, i, FieldIsRequired)
+			return err
+		}
+		return nil
+	}
+
+	switch v := i.(type) {
+	case *string:
+		if v == nil {
+			if r.Required {
+				err.AddError(BaseRuleNameRequired, r.Required, i, FieldIsRequired)
+				return err
+			}
+			return nil
+		}
+		i = *v
+	case string:
+		if v == "" {
+			if r.Required {
+				err.AddError(BaseRuleNameRequired, r.Required, i, FieldIsRequired)
+				return err
+			}
+			return nil
+		}
+	case net.IPNet:
+		i = v.String()
+	case *net.IPNet:
+		if v == nil {
+			if r.Required {
+				err.AddError(BaseRuleNameRequired, r.Required, i, FieldIsRequired)
+				return err
+			}
+			return nil
+		}
+		i = v.String()
+	case netip.Prefix:
+		if !v.IsValid() {
+			if r.Required {
+				err.AddError(BaseRuleNameRequired, r.Required, i, FieldIsRequired)
+				return err
+			}
+			return nil
+		}
+		i = v.String()
+	}
+
+	val, ok := i.(string)
+	if !ok {
+		err.AddError(BaseRuleNameType, TypeCIDR, i, "value must be a string, net.IPNet, or netip.Prefix")
+		return err
+	}
+
+	if val == "" {
+		if r.Required {
+			err.AddError(BaseRuleNameRequired, r.Required, i, FieldIsRequired)
+			return err
+		}
+		return nil
+	}
+
+	prefix, errParse := netip.ParsePrefix(val)
+	if errParse != nil {
+		err.AddError(BaseRuleNameType, TypeCIDR, i, "invalid CIDR notation")
+		return err
+	}
+
+	if r.MinPrefixLen != nil && prefix.Bits() < *r.MinPrefixLen {
+		err.AddError(CIDRRuleNameMinPrefixLen, *r.MinPrefixLen, i, "prefix length is below the minimum")
+		return err
+	}
+
+	if r.MaxPrefixLen != nil && prefix.Bits() > *r.MaxPrefixLen {
+		err.AddError(CIDRRuleNameMaxPrefixLen, *r.MaxPrefixLen, i, "prefix length is above the maximum")
+		return err
+	}
+
+	if r.RequireCanonical && prefix.String() != val {
+		err.AddError(CIDRRuleNameRequireCanon, r.RequireCanonical, i, "CIDR must be in canonical form")
+		return err
+	}
+
+	if r.RequireHostBitsZero && prefix.Masked() != prefix {
+		err.AddError(CIDRRuleNameRequireHostZero, r.RequireHostBitsZero, i, "CIDR must have all host bits zero")
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package sval
+
+import (
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCIDRRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   CIDRRules
+		value   any
+		wantErr bool
+	}{
+		{
+			name:    "empty string when not required",
+			rules:   CIDRRules{},
+			value:   "",
+			wantErr: false,
+		},
+		{
+			name:    "empty string when required",
+			rules:   CIDRRules{BaseRules: BaseRules{Required: true}},
+			value:   "",
+			wantErr: true,
+		},
+		{
+			name:    "nil value when not required",
+			rules:   CIDRRules{},
+			value:   nil,
+			wantErr: false,
+		},
+		{
+			name:    "nil value when required",
+			rules:   CIDRRules{BaseRules: BaseRules{Required: true}},
+			value:   nil,
+			wantErr: true,
+		},
+		{
+			name:    "non-string value",
+			rules:   CIDRRules{},
+			value:   123,
+			wantErr: true,
+		},
+		{
+			name:    "valid IPv4 CIDR",
+			rules:   CIDRRules{},
+			value:   "192.168.1.0/24",
+			wantErr: false,
+		},
+		{
+			name:    "valid IPv6 CIDR",
+			rules:   CIDRRules{},
+			value:   "2001:db8::/32",
+			wantErr: false,
+		},
+		{
+			name:    "invalid CIDR format",
+			rules:   CIDRRules{},
+			value:   "not-a-cidr",
+			wantErr: true,
+		},
+		{
+			name:    "CIDR in net.IPNet format",
+			rules:   CIDRRules{},
+			value:   func() net.IPNet { _, n, _ := net.ParseCIDR("10.0.0.0/8"); return *n }(),
+			wantErr: false,
+		},
+		{
+			name:    "CIDR in *net.IPNet format",
+			rules:   CIDRRules{},
+			value:   func() *net.IPNet { _, n, _ := net.ParseCIDR("10.0.0.0/8"); return n }(),
+			wantErr: false,
+		},
+		{
+			name:    "CIDR in netip.Prefix format",
+			rules:   CIDRRules{},
+			value:   netip.MustParsePrefix("10.0.0.0/8"),
+			wantErr: false,
+		},
+		{
+			name:    "prefix length below minimum",
+			rules:   CIDRRules{MinPrefixLen: ptr(16)},
+			value:   "10.0.0.0/8",
+			wantErr: true,
+		},
+		{
+			name:    "prefix length at minimum",
+			rules:   CIDRRules{MinPrefixLen: ptr(8)},
+			value:   "10.0.0.0/8",
+			wantErr: false,
+		},
+		{
+			name:    "prefix length above maximum",
+			rules:   CIDRRules{MaxPrefixLen: ptr(16)},
+			value:   "192.168.1.0/24",
+			wantErr: true,
+		},
+		{
+			name:    "prefix length at maximum",
+			rules:   CIDRRules{MaxPrefixLen: ptr(24)},
+			value:   "192.168.1.0/24",
+			wantErr: false,
+		},
+		{
+			name:    "non-canonical form rejected",
+			rules:   CIDRRules{RequireCanonical: true},
+			value:   "2001:0db8:0000::1/32",
+			wantErr: true,
+		},
+		{
+			name:    "canonical form accepted",
+			rules:   CIDRRules{RequireCanonical: true},
+			value:   "2001:db8::1/32",
+			wantErr: false,
+		},
+		{
+			name:    "host bits non-zero rejected",
+			rules:   CIDRRules{RequireHostBitsZero: true},
+			value:   "192.168.1.1/24",
+			wantErr: true,
+		},
+		{
+			name:    "host bits zero accepted",
+			rules:   CIDRRules{RequireHostBitsZero: true},
+			value:   "192.168.1.0/24",
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rules.Validate(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err, "Expected error for %s with value %v", tt.name, tt.value)
+			} else {
+				assert.NoError(t, err, "Unexpected error for %s with value %v", tt.name, tt.value)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package sval
+
+import "fmt"
+
+const (
+	CompositeRuleNameAnyOf = "any_of"
+	CompositeRuleNameAllOf = "all_of"
+	CompositeRuleNameOneOf = "one_of"
+	CompositeRuleNameNot   = "not"
+)
+
+// AnyOf composes rules into a RuleSet that passes as soon as one of them
+// passes, failing only when none do. It's the programmatic counterpart of
+// the any_of RuleConfig combinator, for callers building rules in Go instead
+// of from a config file.
+func AnyOf(rules ...RuleSet) RuleSet {
+	return &anyOfRuleSet{rules: rules}
+}
+
+// AllOf composes rules into a RuleSet that requires every one of them to
+// pass, aggregating all of their errors when one or more fail.
+func AllOf(rules ...RuleSet) RuleSet {
+	return &allOfRuleSet{rules: rules}
+}
+
+// OneOf composes rules into a RuleSet that requires exactly one of them to
+// pass.
+func OneOf(rules ...RuleSet) RuleSet {
+	return &oneOfRuleSet{rules: rules}
+}
+
+// Not inverts rule into a RuleSet that passes only when rule itself fails.
+func Not(rule RuleSet) RuleSet {
+	return &notRuleSet{rule: rule}
+}
+
+// runNested runs a composed rule, preferring ValidateWithSiblings so
+// cross-field and When-gated rules keep working when nested inside a
+// combinator.
+func runNested(rs RuleSet, i any, siblings map[string]any) error {
+	if cr, ok := rs.(ContextualRuleSet); ok {
+		return cr.ValidateWithSiblings(i, siblings)
+	}
+	return rs.Validate(i)
+}
+
+func asValidationError(err error) *ValidationError {
+	if ve, ok := err.(*ValidationError); ok {
+		return ve
+	}
+	ve := NewValidationError()
+	ve.AddError("", nil, nil, err.Error())
+	return ve
+}
+
+// allOfRuleSet requires every nested rule to pass, aggregating all of their
+// errors when one or more fail.
+type allOfRuleSet struct {
+	rules []RuleSet
+}
+
+func (r *allOfRuleSet) Validate(i any) error {
+	return r.validate(i, nil)
+}
+
+func (r *allOfRuleSet) ValidateWithSiblings(i any, siblings map[string]any) error {
+	return r.validate(i, siblings)
+}
+
+func (r *allOfRuleSet) validate(i any, siblings map[string]any) error {
+	err := NewValidationError()
+	for _, rs := range r.rules {
+		if sub := runNested(rs, i, siblings); sub != nil {
+			err.AppendError(asValidationError(sub))
+		}
+	}
+	if err.HasErrors() {
+		return err
+	}
+	return nil
+}
+
+// anyOfRuleSet passes as soon as one nested rule passes, failing only when
+// none of them do.
+type anyOfRuleSet struct {
+	rules []RuleSet
+}
+
+func (r *anyOfRuleSet) Validate(i any) error {
+	return r.validate(i, nil)
+}
+
+func (r *anyOfRuleSet) ValidateWithSiblings(i any, siblings map[string]any) error {
+	return r.validate(i, siblings)
+}
+
+func (r *anyOfRuleSet) validate(i any, siblings map[string]any) error {
+	if len(r.rules) == 0 {
+		return nil
+	}
+
+	collected := NewValidationError()
+	for _, rs := range r.rules {
+		sub := runNested(rs, i, siblings)
+		if sub == nil {
+			return nil
+		}
+		collected.AppendError(asValidationError(sub))
+	}
+
+	err := NewValidationError()
+	err.AddError(CompositeRuleNameAnyOf, len(r.rules), i, "value must satisfy at least one of the listed rules")
+	err.AppendError(collected)
+	return err
+}
+
+// oneOfRuleSet requires exactly one nested rule to pass.
+type oneOfRuleSet struct {
+	rules []RuleSet
+}
+
+func (r *oneOfRuleSet) Validate(i any) error {
+	return r.validate(i, nil)
+}
+
+func (r *oneOfRuleSet) ValidateWithSiblings(i any, siblings map[string]any) error {
+	return r.validate(i, siblings)
+}
+
+func (r *oneOfRuleSet) validate(i any, siblings map[string]any) error {
+	passed := 0
+	collected := NewValidationError()
+	for _, rs := range r.rules {
+		sub := runNested(rs, i, siblings)
+		if sub == nil {
+			passed++
+			continue
+		}
+		collected.AppendError(asValidationError(sub))
+	}
+
+	if passed == 1 {
+		return nil
+	}
+
+	err := NewValidationError()
+	err.AddError(CompositeRuleNameOneOf, len(r.rules), i,
+		fmt.Sprintf("value must satisfy exactly one of the listed rules, %d matched", passed))
+	err.AppendError(collected)
+	return err
+}
+
+// notRuleSet inverts a single nested rule: it passes only when the nested
+// rule fails.
+type notRuleSet struct {
+	rule RuleSet
+}
+
+func (r *notRuleSet) Validate(i any) error {
+	return r.validate(i, nil)
+}
+
+func (r *notRuleSet) ValidateWithSiblings(i any, siblings map[string]any) error {
+	return r.validate(i, siblings)
+}
+
+func (r *notRuleSet) validate(i any, siblings map[string]any) error {
+	if sub := runNested(r.rule, i, siblings); sub != nil {
+		return nil
+	}
+
+	err := NewValidationError()
+	err.AddError(CompositeRuleNameNot, nil, i, "value must not satisfy the negated rule")
+	return err
+}
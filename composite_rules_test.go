@@ -0,0 +1,48 @@
+package sval
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnyOf(t *testing.T) {
+	rule := AnyOf(
+		&IPRules{Version: 4, AllowedSubnets: []string{"10.0.0.0/8"}},
+		&EmailRules{AllowedDomains: []string{"corp.example.com"}},
+	)
+
+	assert.NoError(t, rule.Validate("10.1.2.3"))
+	assert.Error(t, rule.Validate("192.168.1.1"))
+}
+
+func TestAllOf(t *testing.T) {
+	rule := AllOf(
+		&StringRules{MinLen: 3},
+		&StringRules{MaxLen: 8},
+	)
+
+	assert.NoError(t, rule.Validate("hello"))
+	assert.Error(t, rule.Validate("hi"))
+	assert.Error(t, rule.Validate("way too long"))
+}
+
+func TestOneOf(t *testing.T) {
+	rule := OneOf(
+		&StringRules{StartsWith: strPtr("a")},
+		&StringRules{EndsWith: strPtr("z")},
+	)
+
+	assert.NoError(t, rule.Validate("apple"))
+	assert.Error(t, rule.Validate("apple to z"))
+	assert.Error(t, rule.Validate("middle"))
+}
+
+func TestNot(t *testing.T) {
+	rule := Not(&StringRules{OnlyDigits: true})
+
+	assert.NoError(t, rule.Validate("abc"))
+	assert.Error(t, rule.Validate("123"))
+}
+
+func strPtr(s string) *string { return &s }
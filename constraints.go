@@ -0,0 +1,190 @@
+package sval
+
+import (
+	"net"
+	"net/netip"
+	"net/url"
+	"strings"
+)
+
+// ConstraintReason is a stable, machine-readable label for why a
+// permitted/excluded name-constraint check failed, modeled after the reason
+// codes RFC 5280 name-constraint implementations (e.g. smallstep's policy
+// engine) surface to callers.
+type ConstraintReason string
+
+const (
+	ConstraintReasonNotPermitted      ConstraintReason = "not_permitted"
+	ConstraintReasonExcluded          ConstraintReason = "excluded"
+	ConstraintReasonCannotParseDomain ConstraintReason = "cannot_parse_domain"
+	ConstraintReasonCannotParseURI    ConstraintReason = "cannot_parse_uri"
+)
+
+// matchDomainConstraint checks name against RFC 5280 style permitted/excluded
+// domain lists: an entry matches name exactly (case-insensitive), or, when
+// the entry starts with ".", matches any subdomain of it. If permitted is
+// non-empty, name must match at least one entry; any excluded match fails
+// immediately regardless of permitted.
+func matchDomainConstraint(name string, permitted, excluded []string) (bool, ConstraintReason) {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	if name == "" {
+		return false, ConstraintReasonCannotParseDomain
+	}
+
+	for _, entry := range excluded {
+		if domainMatches(name, entry) {
+			return false, ConstraintReasonExcluded
+		}
+	}
+
+	if len(permitted) == 0 {
+		return true, ""
+	}
+
+	for _, entry := range permitted {
+		if domainMatches(name, entry) {
+			return true, ""
+		}
+	}
+
+	return false, ConstraintReasonNotPermitted
+}
+
+func domainMatches(name, entry string) bool {
+	entry = strings.ToLower(strings.TrimSuffix(entry, "."))
+	if strings.HasPrefix(entry, ".") {
+		return strings.HasSuffix(name, entry) || name == strings.TrimPrefix(entry, ".")
+	}
+	return name == entry
+}
+
+// matchEmailConstraint checks addr against RFC 5280 style permitted/excluded
+// email lists. An entry may be a full address ("user@example.com"), a bare
+// domain ("example.com"), or a subdomain suffix (".example.com").
+func matchEmailConstraint(addr string, permitted, excluded []string) (bool, ConstraintReason) {
+	atIndex := strings.LastIndex(addr, "@")
+	if atIndex == -1 {
+		return false, ConstraintReasonCannotParseDomain
+	}
+	domain := addr[atIndex+1:]
+
+	for _, entry := range excluded {
+		if emailEntryMatches(addr, domain, entry) {
+			return false, ConstraintReasonExcluded
+		}
+	}
+
+	if len(permitted) == 0 {
+		return true, ""
+	}
+
+	for _, entry := range permitted {
+		if emailEntryMatches(addr, domain, entry) {
+			return true, ""
+		}
+	}
+
+	return false, ConstraintReasonNotPermitted
+}
+
+func emailEntryMatches(addr, domain, entry string) bool {
+	if strings.Contains(entry, "@") {
+		return strings.EqualFold(addr, entry)
+	}
+	return domainMatches(domain, entry)
+}
+
+// compiledRanges is a set of CIDR blocks parsed once up front so IP
+// name-constraint checks don't re-run net.ParseCIDR on every Validate call.
+type compiledRanges []*net.IPNet
+
+// compileCIDRRanges parses each entry as a CIDR block, or, if that fails, as
+// a bare IP address (e.g. "192.168.1.5"), which is widened to a host-only
+// /32 or /128 IPNet so it still matches via (*net.IPNet).Contains.
+func compileCIDRRanges(cidrs []string) (compiledRanges, error) {
+	if len(cidrs) == 0 {
+		return nil, nil
+	}
+
+	ranges := make(compiledRanges, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			ip := net.ParseIP(cidr)
+			if ip == nil {
+				return nil, err
+			}
+			ipNet = hostIPNet(ip)
+		}
+		ranges = append(ranges, ipNet)
+	}
+	return ranges, nil
+}
+
+// hostIPNet widens a single IP into a host-only IPNet (a /32 for IPv4, a
+// /128 for IPv6) so bare-IP entries can be matched the same way as CIDR
+// blocks.
+func hostIPNet(ip net.IP) *net.IPNet {
+	if v4 := ip.To4(); v4 != nil {
+		return &net.IPNet{IP: v4, Mask: net.CIDRMask(32, 32)}
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}
+}
+
+// matchIPConstraint checks ip against permitted/excluded CIDR ranges
+// precompiled by compileCIDRRanges.
+func matchIPConstraint(ip netip.Addr, permitted, excluded compiledRanges) (bool, ConstraintReason) {
+	slice := ip.AsSlice()
+
+	for _, r := range excluded {
+		if r.Contains(slice) {
+			return false, ConstraintReasonExcluded
+		}
+	}
+
+	if len(permitted) == 0 {
+		return true, ""
+	}
+
+	for _, r := range permitted {
+		if r.Contains(slice) {
+			return true, ""
+		}
+	}
+
+	return false, ConstraintReasonNotPermitted
+}
+
+// matchURIConstraint parses rawURI and checks its host against permitted/
+// excluded domain constraints (see matchDomainConstraint).
+func matchURIConstraint(rawURI string, permitted, excluded []string) (bool, ConstraintReason) {
+	parsed, err := url.Parse(rawURI)
+	if err != nil || parsed.Hostname() == "" {
+		return false, ConstraintReasonCannotParseURI
+	}
+	return matchDomainConstraint(parsed.Hostname(), permitted, excluded)
+}
+
+// reasonRuleValue picks which of a permitted/excluded pair a failed
+// constraint check should report as the offending rule value.
+func reasonRuleValue(permitted, excluded []string, reason ConstraintReason) any {
+	if reason == ConstraintReasonExcluded {
+		return excluded
+	}
+	return permitted
+}
+
+// reasonMessage renders a human-readable message for a ConstraintReason,
+// naming what kind of value (domain/address/IP/URI) was rejected.
+func reasonMessage(reason ConstraintReason, kind string) string {
+	switch reason {
+	case ConstraintReasonExcluded:
+		return kind + " matches an excluded entry"
+	case ConstraintReasonCannotParseDomain:
+		return "value is not a valid " + kind
+	case ConstraintReasonCannotParseURI:
+		return "value is not a valid URI"
+	default:
+		return kind + " does not match any permitted entry"
+	}
+}
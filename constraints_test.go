@@ -0,0 +1,231 @@
+package sval
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchDomainConstraint(t *testing.T) {
+	tests := []struct {
+		name       string
+		domain     string
+		permitted  []string
+		excluded   []string
+		wantOK     bool
+		wantReason ConstraintReason
+	}{
+		{
+			name:   "no constraints allows everything",
+			domain: "example.com",
+			wantOK: true,
+		},
+		{
+			name:      "exact permitted match",
+			domain:    "example.com",
+			permitted: []string{"example.com"},
+			wantOK:    true,
+		},
+		{
+			name:      "subdomain permitted match",
+			domain:    "mail.example.com",
+			permitted: []string{".example.com"},
+			wantOK:    true,
+		},
+		{
+			name:       "no permitted match",
+			domain:     "other.com",
+			permitted:  []string{"example.com"},
+			wantOK:     false,
+			wantReason: ConstraintReasonNotPermitted,
+		},
+		{
+			name:       "excluded match wins",
+			domain:     "blocked.example.com",
+			permitted:  []string{".example.com"},
+			excluded:   []string{"blocked.example.com"},
+			wantOK:     false,
+			wantReason: ConstraintReasonExcluded,
+		},
+		{
+			name:       "empty domain cannot be parsed",
+			domain:     "",
+			wantOK:     false,
+			wantReason: ConstraintReasonCannotParseDomain,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, reason := matchDomainConstraint(tt.domain, tt.permitted, tt.excluded)
+			assert.Equal(t, tt.wantOK, ok)
+			if !tt.wantOK {
+				assert.Equal(t, tt.wantReason, reason)
+			}
+		})
+	}
+}
+
+func TestMatchEmailConstraint(t *testing.T) {
+	tests := []struct {
+		name      string
+		addr      string
+		permitted []string
+		excluded  []string
+		wantOK    bool
+	}{
+		{
+			name:   "no constraints allows everything",
+			addr:   "user@example.com",
+			wantOK: true,
+		},
+		{
+			name:      "permitted full address",
+			addr:      "user@example.com",
+			permitted: []string{"user@example.com"},
+			wantOK:    true,
+		},
+		{
+			name:      "permitted bare domain",
+			addr:      "user@example.com",
+			permitted: []string{"example.com"},
+			wantOK:    true,
+		},
+		{
+			name:      "permitted subdomain suffix",
+			addr:      "user@mail.example.com",
+			permitted: []string{".example.com"},
+			wantOK:    true,
+		},
+		{
+			name:      "no permitted match",
+			addr:      "user@other.com",
+			permitted: []string{"example.com"},
+			wantOK:    false,
+		},
+		{
+			name:     "excluded address wins",
+			addr:     "blocked@example.com",
+			excluded: []string{"blocked@example.com"},
+			wantOK:   false,
+		},
+		{
+			name:   "missing at sign cannot be parsed",
+			addr:   "not-an-email",
+			wantOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, _ := matchEmailConstraint(tt.addr, tt.permitted, tt.excluded)
+			assert.Equal(t, tt.wantOK, ok)
+		})
+	}
+}
+
+func TestMatchIPConstraint(t *testing.T) {
+	allowed, err := compileCIDRRanges([]string{"192.168.0.0/16"})
+	assert.NoError(t, err)
+	excluded, err := compileCIDRRanges([]string{"192.168.1.0/24"})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		ip        string
+		permitted compiledRanges
+		excluded  compiledRanges
+		wantOK    bool
+	}{
+		{
+			name:   "no constraints allows everything",
+			ip:     "10.0.0.1",
+			wantOK: true,
+		},
+		{
+			name:      "in permitted range",
+			ip:        "192.168.5.1",
+			permitted: allowed,
+			wantOK:    true,
+		},
+		{
+			name:      "outside permitted range",
+			ip:        "10.0.0.1",
+			permitted: allowed,
+			wantOK:    false,
+		},
+		{
+			name:     "in excluded range",
+			ip:       "192.168.1.5",
+			excluded: excluded,
+			wantOK:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addr := netip.MustParseAddr(tt.ip)
+			ok, _ := matchIPConstraint(addr, tt.permitted, tt.excluded)
+			assert.Equal(t, tt.wantOK, ok)
+		})
+	}
+}
+
+func TestCompileCIDRRanges_BareIPs(t *testing.T) {
+	ranges, err := compileCIDRRanges([]string{"192.168.1.5", "2001:db8::1"})
+	assert.NoError(t, err)
+	assert.Len(t, ranges, 2)
+
+	allowed, _ := matchIPConstraint(netip.MustParseAddr("192.168.1.5"), ranges, nil)
+	assert.True(t, allowed)
+
+	allowed, _ = matchIPConstraint(netip.MustParseAddr("192.168.1.6"), ranges, nil)
+	assert.False(t, allowed)
+
+	allowed, _ = matchIPConstraint(netip.MustParseAddr("2001:db8::1"), ranges, nil)
+	assert.True(t, allowed)
+
+	_, err = compileCIDRRanges([]string{"not-an-ip"})
+	assert.Error(t, err)
+}
+
+func TestMatchURIConstraint(t *testing.T) {
+	tests := []struct {
+		name      string
+		uri       string
+		permitted []string
+		excluded  []string
+		wantOK    bool
+	}{
+		{
+			name:   "no constraints allows everything",
+			uri:    "https://example.com/path",
+			wantOK: true,
+		},
+		{
+			name:      "permitted host match",
+			uri:       "https://example.com/path",
+			permitted: []string{"example.com"},
+			wantOK:    true,
+		},
+		{
+			name:      "host not permitted",
+			uri:       "https://other.com/path",
+			permitted: []string{"example.com"},
+			wantOK:    false,
+		},
+		{
+			name:   "unparsable URI",
+			uri:    "://bad",
+			wantOK: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, _ := matchURIConstraint(tt.uri, tt.permitted, tt.excluded)
+			assert.Equal(t, tt.wantOK, ok)
+		})
+	}
+}
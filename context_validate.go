@@ -0,0 +1,216 @@
+package sval
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"sync"
+)
+
+// defaultMaxParallelism is used when a validator is constructed without
+// WithMaxParallelism.
+const defaultMaxParallelism = 4
+
+// ValidateContext behaves like Validate but walks the struct concurrently:
+// independent field validations are dispatched onto a worker pool bounded by
+// WithMaxParallelism, and outstanding work stops as soon as ctx is cancelled
+// or its deadline expires. This is meant for hot paths validating large
+// request bodies under a timeout, where the expensive rules (password
+// entropy scans, regex compiles, net.ParseCIDR over many IPRules subnets)
+// would otherwise block past the caller's budget.
+func (v *validator) ValidateContext(ctx context.Context, data any) error {
+	pool := newWorkerPool(ctx, v.maxParallelism)
+
+	errs := NewValidationError()
+	var mu sync.Mutex
+
+	pool.run(func() error {
+		return v.validateRecursiveCtx(ctx, pool, reflect.ValueOf(data), validationContext{Path: ""}, &mu, errs)
+	})
+
+	err := pool.wait()
+	v.emit(ctx, ValidationEvent{Topic: EventValidationCompleted})
+	if err != nil {
+		return err
+	}
+
+	if !errs.HasErrors() {
+		return nil
+	}
+
+	if v.translator != nil {
+		v.translateErrors(errs)
+	}
+
+	return errs
+}
+
+// validateRecursiveCtx mirrors validateRecursive, but dispatches each
+// struct field's validation as a separate unit of work on pool instead of
+// recursing inline, and checks ctx.Err() before running a leaf rule.
+func (v *validator) validateRecursiveCtx(ctx context.Context, pool *workerPool, val reflect.Value, vctx validationContext, mu *sync.Mutex, errs *ValidationError) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	normalized := normalizePath(vctx.Path)
+	_, hasRules := v.rules[normalized]
+
+	if val.Kind() == reflect.Ptr {
+		if val.IsNil() && hasRules {
+			return v.runLeafCtx(ctx, val, vctx, mu, errs)
+		}
+		val = val.Elem()
+	}
+
+	switch val.Kind() {
+	case reflect.Struct:
+		typ := val.Type()
+		siblings := collectSiblings(val, typ)
+
+		for i := 0; i < val.NumField(); i++ {
+			field := typ.Field(i)
+			fieldValue := val.Field(i)
+
+			tag := field.Tag.Get("sval")
+			if tag == "" {
+				continue
+			}
+
+			currentPath := tag
+			if vctx.Path != "" {
+				currentPath = vctx.Path + "." + tag
+			}
+			currentCtx := validationContext{Path: currentPath, Siblings: siblings}
+
+			pool.run(func() error {
+				return v.validateRecursiveCtx(ctx, pool, fieldValue, currentCtx, mu, errs)
+			})
+		}
+
+		if crossErr := v.runCrossFieldRules(siblings, vctx.Path); crossErr != nil {
+			mu.Lock()
+			errs.AppendError(crossErr)
+			mu.Unlock()
+		}
+		return nil
+
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < val.Len(); i++ {
+			elem := val.Index(i)
+			newPath := vctx.Path + "[" + strconv.Itoa(i) + "]"
+			newCtx := validationContext{Path: newPath}
+
+			pool.run(func() error {
+				return v.validateRecursiveCtx(ctx, pool, elem, newCtx, mu, errs)
+			})
+		}
+		return nil
+
+	default:
+		return v.runLeafCtx(ctx, val, vctx, mu, errs)
+	}
+}
+
+// runLeafCtx runs a single field's RuleSet, checking ctx.Err() first so
+// already-cancelled work is skipped instead of executing an expensive rule.
+func (v *validator) runLeafCtx(ctx context.Context, val reflect.Value, vctx validationContext, mu *sync.Mutex, errs *ValidationError) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	normalized := normalizePath(vctx.Path)
+	ruleSet, exists := v.rules[normalized]
+	if !exists {
+		return nil
+	}
+
+	var value any
+	if val.IsValid() && val.CanInterface() {
+		value = val.Interface()
+	}
+
+	v.emit(ctx, ValidationEvent{Topic: EventBeforeField, Field: vctx.Path, Value: value})
+
+	var err error
+	if ctxRules, ok := ruleSet.(ContextualRuleSet); ok && vctx.Siblings != nil {
+		err = ctxRules.ValidateWithSiblings(value, vctx.Siblings)
+	} else {
+		err = ruleSet.Validate(value)
+	}
+	if err == nil {
+		v.emit(ctx, ValidationEvent{Topic: EventAfterField, Field: vctx.Path, Value: value})
+		return nil
+	}
+
+	fieldErr := err.(*ValidationError)
+	fieldErr.AddContextToErrors(vctx.Path)
+	v.emitFieldFailures(ctx, vctx.Path, fieldErr)
+
+	mu.Lock()
+	errs.AppendError(fieldErr)
+	mu.Unlock()
+	return nil
+}
+
+// workerPool runs a bounded number of fire-and-forget tasks concurrently,
+// stopping early and surfacing ctx's error as soon as it's cancelled.
+type workerPool struct {
+	ctx    context.Context
+	sem    chan struct{}
+	wg     sync.WaitGroup
+	errMu  sync.Mutex
+	stored error
+}
+
+func newWorkerPool(ctx context.Context, maxParallelism int) *workerPool {
+	if maxParallelism <= 0 {
+		maxParallelism = defaultMaxParallelism
+	}
+	return &workerPool{
+		ctx: ctx,
+		sem: make(chan struct{}, maxParallelism),
+	}
+}
+
+// run schedules task onto a new goroutine, which then waits for a worker
+// slot before actually executing it. Spawning is unbounded (goroutines are
+// cheap) so a task that itself calls run for its children, as
+// validateRecursiveCtx does, never blocks while holding a slot — only the
+// actual rule execution is capped at maxParallelism. Tasks returning a
+// non-nil error (ctx.Err(), in practice) are recorded but don't stop
+// already-running work immediately; wait surfaces the first one and ctx
+// cancellation prevents new work from starting.
+func (p *workerPool) run(task func() error) {
+	if p.ctx.Err() != nil {
+		return
+	}
+
+	p.wg.Add(1)
+
+	go func() {
+		defer p.wg.Done()
+
+		select {
+		case p.sem <- struct{}{}:
+		case <-p.ctx.Done():
+			return
+		}
+		defer func() { <-p.sem }()
+
+		if err := task(); err != nil {
+			p.errMu.Lock()
+			if p.stored == nil {
+				p.stored = err
+			}
+			p.errMu.Unlock()
+		}
+	}()
+}
+
+func (p *workerPool) wait() error {
+	p.wg.Wait()
+	p.errMu.Lock()
+	defer p.errMu.Unlock()
+	return p.stored
+}
@@ -0,0 +1,433 @@
+package sval
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// CrossFieldRuleFunc validates a set of sibling field values together, for
+// checks that don't belong to any single field's own rule - e.g. confirming
+// two passwords match, or that an end date falls after a start date. values
+// holds only the fields the rule declared an interest in via
+// AddCrossFieldRule's fields argument, keyed by their sval tag.
+type CrossFieldRuleFunc func(values map[string]any) error
+
+type crossFieldRuleEntry struct {
+	name   string
+	fields []string
+	fn     CrossFieldRuleFunc
+}
+
+// AddCrossFieldRule registers fn to run once per struct, after that
+// struct's per-field rules have all completed, so long as every field in
+// fields is present among its sibling values - a struct missing one of
+// them (e.g. an omitted optional field) skips the rule rather than erroring.
+// Under ValidateContext it runs once the struct's field validations have
+// been dispatched to the worker pool, so it may run before they finish.
+// A failure is appended to the same *ValidationError per-field rules report
+// into, with Field set to the struct's path (there's no single field to
+// blame) and Rule set to name.
+func (v *validator) AddCrossFieldRule(name string, fields []string, fn CrossFieldRuleFunc) {
+	v.crossFieldRules = append(v.crossFieldRules, crossFieldRuleEntry{name: name, fields: fields, fn: fn})
+}
+
+// runCrossFieldRules evaluates every rule added via AddCrossFieldRule
+// against one struct's sibling values, called from validateRecursive right
+// after its per-field loop.
+func (v *validator) runCrossFieldRules(siblings map[string]any, path string) *ValidationError {
+	if len(v.crossFieldRules) == 0 {
+		return nil
+	}
+
+	errs := NewValidationError()
+	for _, rule := range v.crossFieldRules {
+		values := make(map[string]any, len(rule.fields))
+		missing := false
+		for _, field := range rule.fields {
+			val, ok := siblings[field]
+			if !ok {
+				missing = true
+				break
+			}
+			values[field] = val
+		}
+		if missing {
+			continue
+		}
+
+		if err := rule.fn(values); err != nil {
+			errs.AddError(rule.name, rule.fields, values, err.Error())
+		}
+	}
+
+	if !errs.HasErrors() {
+		return nil
+	}
+	errs.AddContextToErrors(path)
+	return errs
+}
+
+// CrossFieldExprRules is the config-driven counterpart to AddCrossFieldRule:
+// a comparison expression over named sibling fields, declared via
+//
+//	type: cross_field
+//	params:
+//	  fields: [start_date, end_date]
+//	  expr: "end_date > start_date"
+//	  when: "kind == 'ranged'"
+//
+// instead of the single-sibling eq_field/gt_field/lt_field/matches_field
+// comparisons CrossFieldRules offers. Supported operators are ==, !=, <,
+// <=, >, >=, in and matches; operands are either a name from Fields
+// (resolved against sibling values) or a quoted/bare literal. When, if set,
+// gates the whole rule the same way RuleConfig.When gates a plain field
+// rule - a When that doesn't hold (or can't be evaluated, e.g. references a
+// missing field) means the rule is skipped, not failed.
+type CrossFieldExprRules struct {
+	Fields []string `json:"fields" yaml:"fields"`
+	Expr   string   `json:"expr" yaml:"expr"`
+	When   string   `json:"when,omitempty" yaml:"when,omitempty"`
+
+	compileOnce sync.Once
+	compileErr  error
+	expr        *crossFieldExpr
+	when        *crossFieldExpr
+}
+
+// Compile parses Expr (and When, if set) once, validating that every field
+// name Expr references is listed in Fields; When's field names are not
+// checked against Fields. NewValidatorFromConfig
+// calls this via compileRuleSet, so a malformed expression fails at load
+// time instead of on the first Validate call.
+func (r *CrossFieldExprRules) Compile() error {
+	r.compileOnce.Do(func() {
+		expr, err := parseCrossFieldExpr(r.Expr)
+		if err != nil {
+			r.compileErr = fmt.Errorf("expr %q: %w", r.Expr, err)
+			return
+		}
+		if err := expr.checkFieldsDeclared(r.Fields); err != nil {
+			r.compileErr = fmt.Errorf("expr %q: %w", r.Expr, err)
+			return
+		}
+		r.expr = expr
+
+		if r.When == "" {
+			return
+		}
+		when, err := parseCrossFieldExpr(r.When)
+		if err != nil {
+			r.compileErr = fmt.Errorf("when %q: %w", r.When, err)
+			return
+		}
+		r.when = when
+	})
+	return r.compileErr
+}
+
+// Validate runs without sibling context, so there is nothing to compare
+// against; see ValidateWithSiblings.
+func (r *CrossFieldExprRules) Validate(i any) error {
+	return nil
+}
+
+func (r *CrossFieldExprRules) ValidateWithSiblings(i any, siblings map[string]any) error {
+	if err := r.Compile(); err != nil {
+		errs := NewValidationError()
+		errs.AddError("cross_field", r.Expr, i, err.Error())
+		return errs
+	}
+
+	if r.when != nil {
+		ok, err := r.when.evaluate(siblings)
+		if err != nil || !ok {
+			return nil
+		}
+	}
+
+	ok, err := r.expr.evaluate(siblings)
+	errs := NewValidationError()
+	switch {
+	case err != nil:
+		errs.AddError("cross_field", r.Expr, i, err.Error())
+	case !ok:
+		errs.AddError("cross_field", r.Expr, i, fmt.Sprintf("cross-field expression %q did not hold", r.Expr))
+	default:
+		return nil
+	}
+	return errs
+}
+
+// crossFieldOperand is either a reference to a sibling field (isField) or a
+// literal value parsed out of the expression text.
+type crossFieldOperand struct {
+	field   string
+	literal any
+	isField bool
+}
+
+// crossFieldExpr is one parsed "lhs op rhs" comparison.
+type crossFieldExpr struct {
+	lhs     crossFieldOperand
+	op      string
+	rhs     crossFieldOperand
+	rhsList []crossFieldOperand // only set when op == "in"
+	regex   *regexp.Regexp      // only set when op == "matches"
+}
+
+// crossFieldOperators is ordered longest-first, so at a given position " in "
+// or "<=" is matched in full rather than stopping at the "<" prefix.
+var crossFieldOperators = []string{" matches ", " in ", "<=", ">=", "==", "!=", "<", ">"}
+
+// findCrossFieldOperator returns the leftmost operator in raw, ignoring
+// anything inside a quoted literal (e.g. the "<=" in `matches '<=100'`),
+// so a comparison/regex operand can safely contain operator-like text.
+func findCrossFieldOperator(raw string) (idx int, op string) {
+	var quote byte
+	for i := 0; i < len(raw); i++ {
+		c := raw[i]
+		if quote != 0 {
+			if c == quote {
+				quote = 0
+			}
+			continue
+		}
+		if c == '\'' || c == '"' {
+			quote = c
+			continue
+		}
+		for _, candidate := range crossFieldOperators {
+			if strings.HasPrefix(raw[i:], candidate) {
+				return i, candidate
+			}
+		}
+	}
+	return -1, ""
+}
+
+func parseCrossFieldExpr(raw string) (*crossFieldExpr, error) {
+	idx, op := findCrossFieldOperator(raw)
+	if idx < 0 {
+		return nil, fmt.Errorf("no recognized operator (==, !=, <, <=, >, >=, in, matches)")
+	}
+
+	lhs := parseCrossFieldOperand(raw[:idx])
+	rhsRaw := strings.TrimSpace(raw[idx+len(op):])
+	trimmedOp := strings.TrimSpace(op)
+
+	e := &crossFieldExpr{op: trimmedOp, lhs: lhs}
+
+	switch trimmedOp {
+	case "in":
+		list, err := parseCrossFieldList(rhsRaw)
+		if err != nil {
+			return nil, err
+		}
+		e.rhsList = list
+	case "matches":
+		pattern := strings.Trim(rhsRaw, `'"`)
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regex %q: %w", pattern, err)
+		}
+		e.regex = re
+	default:
+		e.rhs = parseCrossFieldOperand(rhsRaw)
+	}
+
+	return e, nil
+}
+
+func parseCrossFieldOperand(raw string) crossFieldOperand {
+	token := strings.TrimSpace(raw)
+
+	if len(token) >= 2 {
+		first, last := token[0], token[len(token)-1]
+		if (first == '\'' || first == '"') && first == last {
+			return crossFieldOperand{literal: token[1 : len(token)-1]}
+		}
+	}
+
+	if f, err := strconv.ParseFloat(token, 64); err == nil {
+		return crossFieldOperand{literal: f}
+	}
+
+	return crossFieldOperand{field: token, isField: true}
+}
+
+// splitCrossFieldList splits an "in" list body on commas, ignoring any comma
+// inside a quoted literal, so `'a,b', 'c'` stays two operands rather than
+// three.
+func splitCrossFieldList(raw string) []string {
+	var parts []string
+	var quote byte
+	start := 0
+	for i := 0; i < len(raw); i++ {
+		c := raw[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == ',':
+			parts = append(parts, raw[start:i])
+			start = i + 1
+		}
+	}
+	parts = append(parts, raw[start:])
+	return parts
+}
+
+func parseCrossFieldList(raw string) ([]crossFieldOperand, error) {
+	raw = strings.TrimSpace(raw)
+	if len(raw) < 2 {
+		return nil, fmt.Errorf("expected a bracketed list, got %q", raw)
+	}
+
+	open, closeCh := raw[0], raw[len(raw)-1]
+	if (open != '[' && open != '(') || (closeCh != ']' && closeCh != ')') {
+		return nil, fmt.Errorf("expected a bracketed list, got %q", raw)
+	}
+
+	parts := splitCrossFieldList(raw[1 : len(raw)-1])
+	operands := make([]crossFieldOperand, 0, len(parts))
+	for _, p := range parts {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		operands = append(operands, parseCrossFieldOperand(p))
+	}
+	return operands, nil
+}
+
+// checkFieldsDeclared rejects an expr that references a field name not in
+// fields, so a typo surfaces as a Compile error instead of a silent
+// "field not found" at Validate time.
+func (e *crossFieldExpr) checkFieldsDeclared(fields []string) error {
+	declared := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		declared[f] = true
+	}
+
+	check := func(op crossFieldOperand) error {
+		if op.isField && !declared[op.field] {
+			return fmt.Errorf("references field %q, which is not listed in fields", op.field)
+		}
+		return nil
+	}
+
+	if err := check(e.lhs); err != nil {
+		return err
+	}
+	if err := check(e.rhs); err != nil {
+		return err
+	}
+	for _, item := range e.rhsList {
+		if err := check(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resolveCrossFieldOperand(op crossFieldOperand, values map[string]any) (any, bool) {
+	if !op.isField {
+		return op.literal, true
+	}
+	v, ok := values[op.field]
+	return v, ok
+}
+
+// crossFieldOperandsEqual backs == and != (and the per-item check for in).
+// It defers to crossFieldCompare first so numeric, time.Time and string
+// operands compare the same way they would under <, <=, >, >= - e.g. two
+// time.Time values for the same instant are equal via time.Time.Equal
+// semantics even when they format differently (monotonic reading present on
+// one, absent on the other). Anything crossFieldCompare can't order (e.g.
+// a bool) falls back to formatted-value equality.
+func crossFieldOperandsEqual(a, b any) bool {
+	if cmp, ok := crossFieldCompare(a, b); ok {
+		return cmp == 0
+	}
+	return fmt.Sprintf("%v", a) == fmt.Sprintf("%v", b)
+}
+
+// crossFieldCompare extends compareFieldValues (numeric/time.Time) with a
+// lexicographic fallback for two plain strings, so "name < other_name"
+// works the same way gt_field/lt_field already do for numbers and dates.
+func crossFieldCompare(a, b any) (int, bool) {
+	if cmp, ok := compareFieldValues(a, b); ok {
+		return cmp, true
+	}
+	as, aOk := a.(string)
+	bs, bOk := b.(string)
+	if aOk && bOk {
+		return strings.Compare(as, bs), true
+	}
+	return 0, false
+}
+
+func (e *crossFieldExpr) evaluate(values map[string]any) (bool, error) {
+	lhs, ok := resolveCrossFieldOperand(e.lhs, values)
+	if !ok {
+		return false, fmt.Errorf("field %q not found", e.lhs.field)
+	}
+
+	switch e.op {
+	case "==", "!=":
+		rhs, ok := resolveCrossFieldOperand(e.rhs, values)
+		if !ok {
+			return false, fmt.Errorf("field %q not found", e.rhs.field)
+		}
+		equal := crossFieldOperandsEqual(lhs, rhs)
+		if e.op == "==" {
+			return equal, nil
+		}
+		return !equal, nil
+
+	case "<", "<=", ">", ">=":
+		rhs, ok := resolveCrossFieldOperand(e.rhs, values)
+		if !ok {
+			return false, fmt.Errorf("field %q not found", e.rhs.field)
+		}
+		cmp, ok := crossFieldCompare(lhs, rhs)
+		if !ok {
+			return false, fmt.Errorf("cannot compare %v and %v", lhs, rhs)
+		}
+		switch e.op {
+		case "<":
+			return cmp < 0, nil
+		case "<=":
+			return cmp <= 0, nil
+		case ">":
+			return cmp > 0, nil
+		default:
+			return cmp >= 0, nil
+		}
+
+	case "in":
+		for _, item := range e.rhsList {
+			val, ok := resolveCrossFieldOperand(item, values)
+			if ok && crossFieldOperandsEqual(lhs, val) {
+				return true, nil
+			}
+		}
+		return false, nil
+
+	case "matches":
+		s, ok := lhs.(string)
+		if !ok {
+			return false, fmt.Errorf("matches requires a string value, got %T", lhs)
+		}
+		return e.regex.MatchString(s), nil
+
+	default:
+		return false, fmt.Errorf("unsupported operator %q", e.op)
+	}
+}
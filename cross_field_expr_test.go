@@ -0,0 +1,247 @@
+package sval
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddCrossFieldRule_FailsWhenMismatched(t *testing.T) {
+	v, err := NewValidatorFromConfig(ValidatorConfig{})
+	assert.NoError(t, err)
+
+	v.AddCrossFieldRule("passwords_match", []string{"password", "confirm_password"}, func(values map[string]any) error {
+		if values["password"] != values["confirm_password"] {
+			return errors.New("passwords do not match")
+		}
+		return nil
+	})
+
+	type TestStruct struct {
+		Password        string `sval:"password"`
+		ConfirmPassword string `sval:"confirm_password"`
+	}
+
+	assert.NoError(t, v.Validate(TestStruct{Password: "hunter2", ConfirmPassword: "hunter2"}))
+
+	err = v.Validate(TestStruct{Password: "hunter2", ConfirmPassword: "other"})
+	assert.Error(t, err)
+
+	ve, ok := err.(*ValidationError)
+	assert.True(t, ok)
+	assert.Equal(t, "passwords_match", ve.Errors()[0].Rule)
+}
+
+func TestAddCrossFieldRule_SkipsWhenFieldMissing(t *testing.T) {
+	v, err := NewValidatorFromConfig(ValidatorConfig{})
+	assert.NoError(t, err)
+
+	called := false
+	v.AddCrossFieldRule("needs_both", []string{"a", "only_a_here"}, func(values map[string]any) error {
+		called = true
+		return nil
+	})
+
+	type TestStruct struct {
+		A string `sval:"a"`
+	}
+
+	assert.NoError(t, v.Validate(TestStruct{A: "x"}))
+	assert.False(t, called, "rule should be skipped when one of its fields isn't present on the struct")
+}
+
+func TestCrossFieldExprRules_NumericComparison(t *testing.T) {
+	v, err := NewValidatorFromConfig(ValidatorConfig{
+		Rules: map[string]RuleConfig{
+			"end_date": {Type: "cross_field", Params: map[string]any{
+				"fields": []any{"start_date", "end_date"},
+				"expr":   "end_date > start_date",
+			}},
+		},
+	})
+	assert.NoError(t, err)
+
+	type TestStruct struct {
+		StartDate int `sval:"start_date"`
+		EndDate   int `sval:"end_date"`
+	}
+
+	assert.NoError(t, v.Validate(TestStruct{StartDate: 1, EndDate: 2}))
+	assert.Error(t, v.Validate(TestStruct{StartDate: 2, EndDate: 1}))
+}
+
+func TestCrossFieldExprRules_EqualityAndIn(t *testing.T) {
+	v, err := NewValidatorFromConfig(ValidatorConfig{
+		Rules: map[string]RuleConfig{
+			"confirm_email": {Type: "cross_field", Params: map[string]any{
+				"fields": []any{"email", "confirm_email"},
+				"expr":   "confirm_email == email",
+			}},
+			"country": {Type: "cross_field", Params: map[string]any{
+				"fields": []any{"country"},
+				"expr":   "country in ['US', 'CA']",
+			}},
+		},
+	})
+	assert.NoError(t, err)
+
+	type TestStruct struct {
+		Email        string `sval:"email"`
+		ConfirmEmail string `sval:"confirm_email"`
+		Country      string `sval:"country"`
+	}
+
+	assert.NoError(t, v.Validate(TestStruct{Email: "a@b.com", ConfirmEmail: "a@b.com", Country: "US"}))
+	assert.Error(t, v.Validate(TestStruct{Email: "a@b.com", ConfirmEmail: "different", Country: "US"}))
+	assert.Error(t, v.Validate(TestStruct{Email: "a@b.com", ConfirmEmail: "a@b.com", Country: "FR"}))
+}
+
+func TestCrossFieldExprRules_MatchesAndWhen(t *testing.T) {
+	v, err := NewValidatorFromConfig(ValidatorConfig{
+		Rules: map[string]RuleConfig{
+			"zip": {Type: "cross_field", Params: map[string]any{
+				"fields": []any{"country", "zip"},
+				"expr":   `zip matches ^\d{5}$`,
+				"when":   "country == 'US'",
+			}},
+		},
+	})
+	assert.NoError(t, err)
+
+	type TestStruct struct {
+		Country string `sval:"country"`
+		Zip     string `sval:"zip"`
+	}
+
+	// country != "US": the rule doesn't apply, so a non-US-shaped zip passes.
+	assert.NoError(t, v.Validate(TestStruct{Country: "FR", Zip: "75008"}))
+
+	// country == "US": the rule applies.
+	assert.NoError(t, v.Validate(TestStruct{Country: "US", Zip: "94107"}))
+	assert.Error(t, v.Validate(TestStruct{Country: "US", Zip: "abc"}))
+}
+
+func TestCrossFieldExprRules_TimeEqualityIgnoresMonotonicReading(t *testing.T) {
+	v, err := NewValidatorFromConfig(ValidatorConfig{
+		Rules: map[string]RuleConfig{
+			"updated_at": {Type: "cross_field", Params: map[string]any{
+				"fields": []any{"created_at", "updated_at"},
+				"expr":   "updated_at == created_at",
+			}},
+		},
+	})
+	assert.NoError(t, err)
+
+	type TestStruct struct {
+		CreatedAt time.Time `sval:"created_at"`
+		UpdatedAt time.Time `sval:"updated_at"`
+	}
+
+	// now carries a monotonic reading; wallClockOnly (round-tripped through
+	// time.Parse) doesn't, so they format differently via %v even though
+	// they represent the same instant.
+	now := time.Now()
+	wallClockOnly, err := time.Parse(time.RFC3339Nano, now.Format(time.RFC3339Nano))
+	assert.NoError(t, err)
+	assert.True(t, now.Equal(wallClockOnly))
+	assert.NotEqual(t, now.String(), wallClockOnly.String())
+
+	assert.NoError(t, v.Validate(TestStruct{CreatedAt: now, UpdatedAt: wallClockOnly}))
+}
+
+func TestCrossFieldExprRules_OperatorInsideQuotedLiteralIsNotMistakenForRealOperator(t *testing.T) {
+	v, err := NewValidatorFromConfig(ValidatorConfig{
+		Rules: map[string]RuleConfig{
+			"price": {Type: "cross_field", Params: map[string]any{
+				"fields": []any{"price"},
+				"expr":   `price matches '<=100'`,
+			}},
+		},
+	})
+	assert.NoError(t, err)
+
+	type TestStruct struct {
+		Price string `sval:"price"`
+	}
+
+	// The "<=" inside the quoted regex literal must not be mistaken for a
+	// comparison operator - the field being checked is still price, against
+	// the literal regex "<=100".
+	assert.NoError(t, v.Validate(TestStruct{Price: "<=100"}))
+	assert.Error(t, v.Validate(TestStruct{Price: "100"}))
+}
+
+func TestParseCrossFieldConfig_RejectsLegacyKeyAlongsideExpr(t *testing.T) {
+	_, err := NewValidatorFromConfig(ValidatorConfig{
+		Rules: map[string]RuleConfig{
+			"confirm_password": {Type: "cross_field", Params: map[string]any{
+				"fields":   []any{"password", "confirm_password"},
+				"expr":     "confirm_password == password",
+				"eq_field": "password",
+			}},
+		},
+	})
+	assert.Error(t, err)
+}
+
+func TestCrossFieldExprRules_InListLiteralMayContainComma(t *testing.T) {
+	v, err := NewValidatorFromConfig(ValidatorConfig{
+		Rules: map[string]RuleConfig{
+			"tag": {Type: "cross_field", Params: map[string]any{
+				"fields": []any{"tag"},
+				"expr":   `tag in ['a,b', 'c']`,
+			}},
+		},
+	})
+	assert.NoError(t, err)
+
+	type TestStruct struct {
+		Tag string `sval:"tag"`
+	}
+
+	assert.NoError(t, v.Validate(TestStruct{Tag: "a,b"}))
+	assert.NoError(t, v.Validate(TestStruct{Tag: "c"}))
+	assert.Error(t, v.Validate(TestStruct{Tag: "a"}))
+}
+
+func TestCrossFieldExprRules_CompileErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		params map[string]any
+	}{
+		{
+			name: "no recognized operator",
+			params: map[string]any{
+				"fields": []any{"a", "b"},
+				"expr":   "a squiggly b",
+			},
+		},
+		{
+			name: "undeclared field reference",
+			params: map[string]any{
+				"fields": []any{"a"},
+				"expr":   "a == b",
+			},
+		},
+		{
+			name: "invalid regex",
+			params: map[string]any{
+				"fields": []any{"a"},
+				"expr":   "a matches (unterminated",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewValidatorFromConfig(ValidatorConfig{
+				Rules: map[string]RuleConfig{
+					"a": {Type: "cross_field", Params: tt.params},
+				},
+			})
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package sval
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+type CrossFieldRuleName = string
+
+const (
+	CrossFieldRuleNameEqField      CrossFieldRuleName = "eq_field"
+	CrossFieldRuleNameGtField      CrossFieldRuleName = "gt_field"
+	CrossFieldRuleNameLtField      CrossFieldRuleName = "lt_field"
+	CrossFieldRuleNameMatchesField CrossFieldRuleName = "matches_field"
+)
+
+// CrossFieldRules compares the validated value against a sibling field,
+// resolved at Validate time from the enclosing struct/slice element, e.g.
+// "confirm_password must equal password" or "end_date must be after start_date".
+type CrossFieldRules struct {
+	BaseRules
+	EqField      string `json:"eq_field,omitempty" yaml:"eq_field,omitempty"`
+	GtField      string `json:"gt_field,omitempty" yaml:"gt_field,omitempty"`
+	LtField      string `json:"lt_field,omitempty" yaml:"lt_field,omitempty"`
+	MatchesField string `json:"matches_field,omitempty" yaml:"matches_field,omitempty"`
+}
+
+// Validate runs without sibling context, so there is nothing to compare
+// against; use ValidateWithSiblings (wired in automatically for struct
+// fields by validateRecursive) to actually enforce the comparisons.
+func (r *CrossFieldRules) Validate(i any) error {
+	return nil
+}
+
+func (r *CrossFieldRules) ValidateWithSiblings(i any, siblings map[string]any) error {
+	err := NewValidationError()
+
+	if r.EqField != "" {
+		other, ok := siblings[r.EqField]
+		if !ok || !reflect.DeepEqual(i, other) {
+			err.AddError(CrossFieldRuleNameEqField, r.EqField, i, fmt.Sprintf("value must equal field %q", r.EqField))
+		}
+	}
+
+	if r.GtField != "" {
+		other, ok := siblings[r.GtField]
+		cmp, cmpOk := compareFieldValues(i, other)
+		if !ok || !cmpOk || cmp <= 0 {
+			err.AddError(CrossFieldRuleNameGtField, r.GtField, i, fmt.Sprintf("value must be greater than field %q", r.GtField))
+		}
+	}
+
+	if r.LtField != "" {
+		other, ok := siblings[r.LtField]
+		cmp, cmpOk := compareFieldValues(i, other)
+		if !ok || !cmpOk || cmp >= 0 {
+			err.AddError(CrossFieldRuleNameLtField, r.LtField, i, fmt.Sprintf("value must be less than field %q", r.LtField))
+		}
+	}
+
+	if r.MatchesField != "" {
+		other, ok := siblings[r.MatchesField]
+		val, valOk := i.(string)
+		otherVal, otherOk := other.(string)
+		if !ok || !valOk || !otherOk || val != otherVal {
+			err.AddError(CrossFieldRuleNameMatchesField, r.MatchesField, i, fmt.Sprintf("value must match field %q", r.MatchesField))
+		}
+	}
+
+	if err.HasErrors() {
+		return err
+	}
+	return nil
+}
+
+// compareFieldValues compares two values that are either both numeric or
+// both time.Time, returning -1/0/1 like a standard comparator. ok is false
+// when the values aren't of a comparable kind.
+func compareFieldValues(a, b any) (int, bool) {
+	if at, ok := a.(time.Time); ok {
+		bt, ok := b.(time.Time)
+		if !ok {
+			return 0, false
+		}
+		switch {
+		case at.Before(bt):
+			return -1, true
+		case at.After(bt):
+			return 1, true
+		default:
+			return 0, true
+		}
+	}
+
+	af, aOk := toFloat64(a)
+	bf, bOk := toFloat64(b)
+	if !aOk || !bOk {
+		return 0, false
+	}
+
+	switch {
+	case af < bf:
+		return -1, true
+	case af > bf:
+		return 1, true
+	default:
+		return 0, true
+	}
+}
+
+func toFloat64(v any) (float64, bool) {
+	switch val := v.(type) {
+	case int:
+		return float64(val), true
+	case int32:
+		return float64(val), true
+	case int64:
+		return float64(val), true
+	case float32:
+		return float64(val), true
+	case float64:
+		return val, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,71 @@
+package sval
+
+type DNSRuleName = string
+
+const (
+	DNSRuleNamePermittedDomains DNSRuleName = "permitted_domains"
+	DNSRuleNameExcludedDomains  DNSRuleName = "excluded_domains"
+)
+
+// DNSRules validates a DNS domain name against RFC 5280 style name
+// constraints: PermittedDomains/ExcludedDomains entries match the domain
+// exactly, or, prefixed with ".", match it and any of its subdomains. See
+// matchDomainConstraint in constraints.go.
+type DNSRules struct {
+	BaseRules
+	PermittedDomains []string `json:"permitted_domains,omitempty" yaml:"permitted_domains"`
+	ExcludedDomains  []string `json:"excluded_domains,omitempty" yaml:"excluded_domains"`
+}
+
+func (r *DNSRules) Validate(i any) error {
+	err := NewValidationError()
+
+	if i == nil {
+		if r.Required {
+			err.AddError(BaseRuleNameRequired, r.Required, i, FieldIsRequired)
+			return err
+		}
+		return nil
+	}
+
+	if ptr, ok := i.(*string); ok {
+		if ptr == nil {
+			if r.Required {
+				err.AddError(BaseRuleNameRequired, r.Required, i, FieldIsRequired)
+				return err
+			}
+			return nil
+		}
+		i = *ptr
+	}
+
+	val, ok := i.(string)
+	if !ok {
+		err.AddError(BaseRuleNameType, TypeDNS, i, "value must be a string")
+		return err
+	}
+
+	if val == "" {
+		if r.Required {
+			err.AddError(BaseRuleNameRequired, r.Required, i, FieldIsRequired)
+			return err
+		}
+		return nil
+	}
+
+	if len(r.PermittedDomains) == 0 && len(r.ExcludedDomains) == 0 {
+		return nil
+	}
+
+	allowed, reason := matchDomainConstraint(val, r.PermittedDomains, r.ExcludedDomains)
+	if !allowed {
+		rule := DNSRuleNamePermittedDomains
+		if reason == ConstraintReasonExcluded {
+			rule = DNSRuleNameExcludedDomains
+		}
+		err.AddError(rule, reasonRuleValue(r.PermittedDomains, r.ExcludedDomains, reason), i, reasonMessage(reason, "domain"))
+		return err
+	}
+
+	return nil
+}
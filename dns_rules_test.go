@@ -0,0 +1,91 @@
+package sval
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDNSRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   DNSRules
+		value   any
+		wantErr bool
+	}{
+		{
+			name:    "empty string when not required",
+			rules:   DNSRules{},
+			value:   "",
+			wantErr: false,
+		},
+		{
+			name:    "empty string when required",
+			rules:   DNSRules{BaseRules: BaseRules{Required: true}},
+			value:   "",
+			wantErr: true,
+		},
+		{
+			name:    "nil value when required",
+			rules:   DNSRules{BaseRules: BaseRules{Required: true}},
+			value:   nil,
+			wantErr: true,
+		},
+		{
+			name:    "non-string value",
+			rules:   DNSRules{},
+			value:   123,
+			wantErr: true,
+		},
+		{
+			name:    "no constraints configured",
+			rules:   DNSRules{},
+			value:   "example.com",
+			wantErr: false,
+		},
+		{
+			name:    "matches permitted domain exactly",
+			rules:   DNSRules{PermittedDomains: []string{"example.com"}},
+			value:   "example.com",
+			wantErr: false,
+		},
+		{
+			name:    "matches permitted subdomain",
+			rules:   DNSRules{PermittedDomains: []string{".example.com"}},
+			value:   "mail.example.com",
+			wantErr: false,
+		},
+		{
+			name:    "does not match any permitted domain",
+			rules:   DNSRules{PermittedDomains: []string{"example.com"}},
+			value:   "other.com",
+			wantErr: true,
+		},
+		{
+			name:    "matches excluded domain",
+			rules:   DNSRules{ExcludedDomains: []string{"blocked.com"}},
+			value:   "blocked.com",
+			wantErr: true,
+		},
+		{
+			name: "excluded wins over permitted",
+			rules: DNSRules{
+				PermittedDomains: []string{".example.com"},
+				ExcludedDomains:  []string{"internal.example.com"},
+			},
+			value:   "internal.example.com",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rules.Validate(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err, "Expected error for %s with value %v", tt.name, tt.value)
+			} else {
+				assert.NoError(t, err, "Unexpected error for %s with value %v", tt.name, tt.value)
+			}
+		})
+	}
+}
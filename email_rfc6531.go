@@ -0,0 +1,117 @@
+package sval
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/net/idna"
+)
+
+// RFC6531 accepts internationalized email addresses (SMTPUTF8): UTF-8 local
+// parts per RFC 6531 and IDN domains per RFC 5890, on top of RFC5322's
+// structural rules (length limits, no leading/trailing/consecutive dots).
+const RFC6531 EmailValidationStrategy = "rfc6531"
+
+// validateEmailRFC6531 checks email per RFC 6531
+func validateEmailRFC6531(email string) bool {
+	if utf8.RuneCountInString(email) == 0 {
+		return false
+	}
+
+	atIndex := strings.LastIndex(email, "@")
+	if atIndex == -1 || atIndex == 0 || atIndex == len(email)-1 {
+		return false
+	}
+
+	local := email[:atIndex]
+	domain := email[atIndex+1:]
+
+	return validateLocalRFC6531(local) && validateDomainRFC6531(domain)
+}
+
+func validateLocalRFC6531(local string) bool {
+	length := utf8.RuneCountInString(local)
+	if length == 0 || length > maxLocalLength {
+		return false
+	}
+
+	if strings.HasPrefix(local, ".") || strings.HasSuffix(local, ".") {
+		return false
+	}
+	if strings.Contains(local, "..") {
+		return false
+	}
+
+	for _, c := range local {
+		if !isAllowedLocalCharUTF8(c) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isAllowedLocalCharUTF8 permits any letter, mark, number, or punctuation
+// rune per RFC 6531, except the ASCII specials that would make the address
+// ambiguous to parse: '@', ',', whitespace, and control characters. '.' is
+// allowed here and constrained separately (no leading/trailing/consecutive
+// dots) by validateLocalRFC6531.
+func isAllowedLocalCharUTF8(c rune) bool {
+	switch c {
+	case '@', ',':
+		return false
+	}
+	if unicode.IsSpace(c) || unicode.IsControl(c) {
+		return false
+	}
+	return unicode.IsLetter(c) || unicode.IsMark(c) || unicode.IsNumber(c) || unicode.IsPunct(c)
+}
+
+// validateDomainRFC6531 converts each label to its IDNA ASCII (punycode)
+// form and runs the existing validateLabel length/hyphen checks against
+// that form, so a Unicode domain like "münchen.de" is checked exactly as its
+// A-label form "xn--mnchen-3ya.de" would be - the idna conversion also rejects
+// malformed/disallowed labels (e.g. "-bad-") before validateLabel ever runs.
+func validateDomainRFC6531(domain string) bool {
+	if utf8.RuneCountInString(domain) == 0 || len(domain) > maxDomainLength {
+		return false
+	}
+
+	labels := strings.Split(domain, ".")
+	if len(labels) < 2 {
+		return false
+	}
+
+	for _, label := range labels {
+		ascii, err := idna.Lookup.ToASCII(label)
+		if err != nil || !validateLabel(ascii) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// NormalizedEmail returns email with its domain converted to IDNA ASCII
+// (punycode) form, e.g. "user@пример.рф" -> "user@xn--e1afmkfd.xn--p1ai",
+// suitable for storage/lookup where a canonical form is needed. The local
+// part is returned unchanged, since RFC 6531 makes no case- or
+// normalization guarantees about it.
+func NormalizedEmail(email string) (string, error) {
+	atIndex := strings.LastIndex(email, "@")
+	if atIndex == -1 || atIndex == 0 || atIndex == len(email)-1 {
+		return "", fmt.Errorf("sval: %q is not a valid email address", email)
+	}
+
+	local := email[:atIndex]
+	domain := email[atIndex+1:]
+
+	ascii, err := idna.Lookup.ToASCII(domain)
+	if err != nil {
+		return "", fmt.Errorf("sval: normalizing domain %q: %w", domain, err)
+	}
+
+	return local + "@" + ascii, nil
+}
@@ -0,0 +1,30 @@
+package sval
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizedEmail(t *testing.T) {
+	normalized, err := NormalizedEmail("user@пример.рф")
+	assert.NoError(t, err)
+	assert.Equal(t, "user@xn--e1afmkfd.xn--p1ai", normalized)
+
+	normalized, err = NormalizedEmail("user@example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "user@example.com", normalized)
+
+	normalized, err = NormalizedEmail("δοκιμή@παράδειγμα.ελ")
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(normalized, "δοκιμή@xn--"))
+}
+
+func TestNormalizedEmail_Invalid(t *testing.T) {
+	_, err := NormalizedEmail("not-an-email")
+	assert.Error(t, err)
+
+	_, err = NormalizedEmail("@example.com")
+	assert.Error(t, err)
+}
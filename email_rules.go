@@ -1,6 +1,7 @@
 package sval
 
 import (
+	"net"
 	"regexp"
 	"slices"
 	"strings"
@@ -10,25 +11,109 @@ type EmailRuleName = string
 
 const (
 	EmailRuleNameStrategy        EmailRuleName = "strategy"
+	EmailRuleNameMode            EmailRuleName = "mode"
 	EmailRuleNameMinDomainLen    EmailRuleName = "min_domain_len"
 	EmailRuleNameExcludedDomains EmailRuleName = "excluded_domains"
 	EmailRuleNameAllowedDomains  EmailRuleName = "allowed_domains"
+	EmailRuleNameBlockedDomains  EmailRuleName = "blocked_domains"
 	EmailRuleNameRegexp          EmailRuleName = "regex"
+	EmailRuleNamePermittedAddrs  EmailRuleName = "permitted_addresses"
+	EmailRuleNameExcludedAddrs   EmailRuleName = "excluded_addresses"
+	EmailRuleNameRequireTLD      EmailRuleName = "require_tld"
+	EmailRuleNameMaxLocalLen     EmailRuleName = "max_local_len"
+	EmailRuleNameMaxTotalLen     EmailRuleName = "max_total_len"
+	EmailRuleNameCheckMX         EmailRuleName = "check_mx"
 )
 
+// Mode values name the same grammars as EmailValidationStrategy's
+// RFC5321/RFC5322/HTMLInput constants, using the spellings this package's
+// config examples favor. modeStrategy maps each to the
+// EmailValidationStrategy that actually enforces it, so Mode and the older
+// Strategy field share one validation path.
+const (
+	ModeRFC5322     = "rfc5322"
+	ModeRFC5321SMTP = "rfc5321_smtp"
+	ModeHTML5       = "html5"
+	ModeSMTPUTF8    = "smtputf8"
+)
+
+var modeStrategy = map[string]EmailValidationStrategy{
+	ModeRFC5322:     RFC5322,
+	ModeRFC5321SMTP: RFC5321,
+	ModeHTML5:       HTMLInput,
+	ModeSMTPUTF8:    RFC6531,
+}
+
 var (
 	// TODO: remove global regex, use compiled regex in rules
 	emailRegexp *regexp.Regexp
 )
 
+// EmailResolver abstracts the DNS lookups behind EmailRules.CheckMX, so
+// tests can stub them instead of depending on real network access. The
+// package default backs onto net.LookupMX/net.LookupHost.
+type EmailResolver interface {
+	LookupMX(domain string) ([]*net.MX, error)
+	LookupHost(domain string) ([]string, error)
+}
+
+type netEmailResolver struct{}
+
+func (netEmailResolver) LookupMX(domain string) ([]*net.MX, error) {
+	return net.LookupMX(domain)
+}
+
+func (netEmailResolver) LookupHost(domain string) ([]string, error) {
+	return net.LookupHost(domain)
+}
+
+var defaultEmailResolver EmailResolver = netEmailResolver{}
+
 type EmailRules struct {
 	BaseRules
-	Strategy        string   `json:"strategy" yaml:"strategy"`
+	Strategy string `json:"strategy" yaml:"strategy"`
+	// Mode is an alternative to Strategy using the rfc5322/rfc5321_smtp/
+	// html5 spellings; when set, it takes precedence over Strategy.
+	Mode            string   `json:"mode,omitempty" yaml:"mode,omitempty"`
 	MinDomainLen    int      `json:"min_domain_len" yaml:"min_domain_len"`
 	ExcludedDomains []string `json:"excluded_domains" yaml:"excluded_domains"`
 	AllowedDomains  []string `json:"allowed_domains" yaml:"allowed_domains"`
-	Regex           *string  `json:"regex,omitempty" yaml:"regex,omitempty"`
+	// BlockedDomains applies RFC 5280 style suffix matching (see
+	// domainMatches in constraints.go): a ".acme.org" entry also blocks
+	// every subdomain of acme.org, unlike the exact-match ExcludedDomains
+	// above.
+	BlockedDomains []string `json:"blocked_domains,omitempty" yaml:"blocked_domains,omitempty"`
+	// IDN opts into IDNA normalization (see toASCIIDomain in idn.go): the
+	// domain is converted to its Punycode A-label form before the strategy
+	// check and before comparing against AllowedDomains/ExcludedDomains/
+	// BlockedDomains, so "user@münchen.de" and "user@xn--mnchen-3ya.de" are
+	// treated as the same address. Off by default, so existing configs keep
+	// comparing raw domain bytes.
+	IDN   bool    `json:"idn,omitempty" yaml:"idn,omitempty"`
+	Regex *string `json:"regex,omitempty" yaml:"regex,omitempty"`
 	// TODO: add compiled regex for performance
+
+	// PermittedAddresses/ExcludedAddresses apply RFC 5280 style email
+	// name-constraint matching (see matchEmailConstraint in constraints.go):
+	// an entry may be a full address, a bare domain, or a ".subdomain" form,
+	// unlike the exact-match AllowedDomains/ExcludedDomains above.
+	PermittedAddresses []string `json:"permitted_addresses,omitempty" yaml:"permitted_addresses"`
+	ExcludedAddresses  []string `json:"excluded_addresses,omitempty" yaml:"excluded_addresses"`
+
+	// RequireTLD rejects single-label domains ("user@localhost").
+	RequireTLD bool `json:"require_tld,omitempty" yaml:"require_tld,omitempty"`
+	// MaxLocalLen/MaxTotalLen cap the local part and the address as a whole,
+	// per the RFC 5321 limits of 64 and 254 octets. Zero means no cap.
+	MaxLocalLen int `json:"max_local_len,omitempty" yaml:"max_local_len,omitempty"`
+	MaxTotalLen int `json:"max_total_len,omitempty" yaml:"max_total_len,omitempty"`
+
+	// CheckMX additionally requires the domain to resolve an MX record, or
+	// an A/AAAA record as SMTP's implicit-MX fallback. Off by default,
+	// since it makes validation depend on network access.
+	CheckMX bool `json:"check_mx,omitempty" yaml:"check_mx,omitempty"`
+	// Resolver overrides the DNS lookups CheckMX runs. A nil value falls
+	// back to the real net.LookupMX/net.LookupHost.
+	Resolver EmailResolver `json:"-" yaml:"-"`
 }
 
 func (r *EmailRules) Validate(i any) error {
@@ -67,10 +152,8 @@ func (r *EmailRules) Validate(i any) error {
 		return nil
 	}
 
-	if r.Strategy != "" {
-		if !validateEmail(val, EmailValidationStrategy(r.Strategy)) {
-			err.AddError(EmailRuleNameStrategy, r.Strategy, i, "email does not conform to chosen strategy")
-		}
+	if r.MaxTotalLen > 0 && len(val) > r.MaxTotalLen {
+		err.AddError(EmailRuleNameMaxTotalLen, r.MaxTotalLen, i, "email address is too long")
 	}
 
 	atIndex := strings.LastIndex(val, "@")
@@ -78,25 +161,71 @@ func (r *EmailRules) Validate(i any) error {
 		return err
 	}
 
+	local := val[:atIndex]
+	if r.MaxLocalLen > 0 && len(local) > r.MaxLocalLen {
+		err.AddError(EmailRuleNameMaxLocalLen, r.MaxLocalLen, i, "local part of email is too long")
+	}
+
 	domain := val[atIndex+1:]
 	if r.MinDomainLen > 0 && len(domain) < r.MinDomainLen {
 		err.AddError(EmailRuleNameMinDomainLen, r.MinDomainLen, i, "domain part of email is too short")
 	}
 
+	if r.RequireTLD && !strings.Contains(domain, ".") {
+		err.AddError(EmailRuleNameRequireTLD, r.RequireTLD, i, "domain must include a top-level domain")
+	}
+
+	// normalizedDomain is what the strategy check and the
+	// Allowed/Excluded/BlockedDomains comparisons below actually match
+	// against. With IDN set it's domain's IDNA ASCII (punycode) form, so
+	// e.g. "münchen.de" and "xn--mnchen-3ya.de" compare equal; otherwise
+	// it's the raw domain, matching byte-for-byte as before.
+	normalizedDomain := domain
+	if r.IDN {
+		normalizedDomain = toASCIIDomain(domain)
+	}
+
+	strategy := EmailValidationStrategy(r.Strategy)
+	if r.Mode != "" {
+		if s, ok := modeStrategy[r.Mode]; ok {
+			strategy = s
+		} else {
+			err.AddError(EmailRuleNameMode, r.Mode, i, "unknown email mode")
+		}
+	}
+	if strategy != "" {
+		strategyVal := val
+		if r.IDN {
+			strategyVal = local + "@" + normalizedDomain
+		}
+		if !validateEmail(strategyVal, strategy) {
+			err.AddError(EmailRuleNameStrategy, string(strategy), i, "email does not conform to chosen strategy")
+		}
+	}
+
 	if len(r.ExcludedDomains) > 0 {
 		for _, excluded := range r.ExcludedDomains {
-			if domain == excluded {
+			if normalizedDomain == excluded {
 				err.AddError(EmailRuleNameExcludedDomains, r.ExcludedDomains, i, "email domain is excluded")
 			}
 		}
 	}
 
 	if len(r.AllowedDomains) > 0 {
-		if !slices.Contains(r.AllowedDomains, domain) {
+		if !slices.Contains(r.AllowedDomains, normalizedDomain) {
 			err.AddError(EmailRuleNameAllowedDomains, r.AllowedDomains, i, "email domain is not allowed")
 		}
 	}
 
+	if len(r.BlockedDomains) > 0 {
+		for _, blocked := range r.BlockedDomains {
+			if domainMatches(normalizedDomain, blocked) {
+				err.AddError(EmailRuleNameBlockedDomains, r.BlockedDomains, i, "email domain is blocked")
+				break
+			}
+		}
+	}
+
 	if r.Regex != nil {
 		// TODO: compilation will be removed to avoid performance issues
 		re, compileErr := regexp.Compile(*r.Regex)
@@ -105,6 +234,24 @@ func (r *EmailRules) Validate(i any) error {
 		}
 	}
 
+	if len(r.PermittedAddresses) > 0 || len(r.ExcludedAddresses) > 0 {
+		if allowed, reason := matchEmailConstraint(val, r.PermittedAddresses, r.ExcludedAddresses); !allowed {
+			rule := EmailRuleNamePermittedAddrs
+			ruleValue := r.PermittedAddresses
+			if reason == ConstraintReasonExcluded {
+				rule = EmailRuleNameExcludedAddrs
+				ruleValue = r.ExcludedAddresses
+			}
+			err.AddError(rule, ruleValue, i, reasonMessage(reason, "email address"))
+		}
+	}
+
+	if r.CheckMX && !err.HasErrors() {
+		if deliverable := r.checkDeliverable(normalizedDomain); !deliverable {
+			err.AddError(EmailRuleNameCheckMX, r.CheckMX, i, "email domain has no MX or A/AAAA record")
+		}
+	}
+
 	if err.HasErrors() {
 		return err
 	}
@@ -112,6 +259,22 @@ func (r *EmailRules) Validate(i any) error {
 	return nil
 }
 
+// checkDeliverable reports whether domain can receive mail: it has an MX
+// record, or, per RFC 5321's implicit-MX fallback, an A/AAAA record.
+func (r *EmailRules) checkDeliverable(domain string) bool {
+	resolver := r.Resolver
+	if resolver == nil {
+		resolver = defaultEmailResolver
+	}
+
+	if mxs, err := resolver.LookupMX(domain); err == nil && len(mxs) > 0 {
+		return true
+	}
+
+	_, err := resolver.LookupHost(domain)
+	return err == nil
+}
+
 func matchRegex(value string) bool {
 	return emailRegexp.MatchString(value)
 }
@@ -1,6 +1,8 @@
 package sval
 
 import (
+	"errors"
+	"net"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -102,6 +104,53 @@ func TestEmailRules(t *testing.T) {
 			wantErr:  false,
 			expected: nil,
 		},
+
+		// PermittedAddresses/ExcludedAddresses name-constraint tests
+		{
+			name: "permitted addresses - matches domain entry",
+			rules: &EmailRules{
+				PermittedAddresses: []string{"example.com"},
+			},
+			input:    "user@example.com",
+			wantErr:  false,
+			expected: nil,
+		},
+		{
+			name: "permitted addresses - matches subdomain entry",
+			rules: &EmailRules{
+				PermittedAddresses: []string{".example.com"},
+			},
+			input:    "user@mail.example.com",
+			wantErr:  false,
+			expected: nil,
+		},
+		{
+			name: "permitted addresses - no match",
+			rules: &EmailRules{
+				PermittedAddresses: []string{"example.com"},
+			},
+			input:   "user@other.com",
+			wantErr: true,
+			expected: func() error {
+				err := NewValidationError()
+				err.AddError(EmailRuleNamePermittedAddrs, []string{"example.com"}, "user@other.com", "email address does not match any permitted entry")
+				return err
+			}(),
+		},
+		{
+			name: "excluded addresses - full address match wins over permitted",
+			rules: &EmailRules{
+				PermittedAddresses: []string{"example.com"},
+				ExcludedAddresses:  []string{"blocked@example.com"},
+			},
+			input:   "blocked@example.com",
+			wantErr: true,
+			expected: func() error {
+				err := NewValidationError()
+				err.AddError(EmailRuleNameExcludedAddrs, []string{"blocked@example.com"}, "blocked@example.com", "email address matches an excluded entry")
+				return err
+			}(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -116,3 +165,80 @@ func TestEmailRules(t *testing.T) {
 		})
 	}
 }
+
+func TestEmailRules_Mode(t *testing.T) {
+	rules := &EmailRules{Mode: ModeHTML5}
+	assert.NoError(t, rules.Validate("user@example.com"))
+
+	invalid := &EmailRules{Mode: "not_a_mode"}
+	assert.Error(t, invalid.Validate("user@example.com"))
+}
+
+func TestEmailRules_BlockedDomains(t *testing.T) {
+	rules := &EmailRules{BlockedDomains: []string{".acme.org"}}
+
+	assert.NoError(t, rules.Validate("user@example.com"))
+	assert.Error(t, rules.Validate("user@mail.acme.org"))
+}
+
+func TestEmailRules_RequireTLD(t *testing.T) {
+	rules := &EmailRules{RequireTLD: true}
+
+	assert.NoError(t, rules.Validate("user@example.com"))
+	assert.Error(t, rules.Validate("user@localhost"))
+}
+
+func TestEmailRules_MaxLocalAndTotalLen(t *testing.T) {
+	rules := &EmailRules{MaxLocalLen: 3, MaxTotalLen: 15}
+
+	assert.NoError(t, rules.Validate("abc@example.com"))
+	assert.Error(t, rules.Validate("abcd@example.com"), "local part exceeds MaxLocalLen")
+	assert.Error(t, rules.Validate("abc@example-long.com"), "address exceeds MaxTotalLen")
+}
+
+func TestEmailRules_IDNADomainNormalization(t *testing.T) {
+	rules := &EmailRules{IDN: true, AllowedDomains: []string{"xn--mnchen-3ya.de"}}
+
+	assert.NoError(t, rules.Validate("user@münchen.de"))
+	assert.NoError(t, rules.Validate("user@xn--mnchen-3ya.de"))
+
+	withoutIDN := &EmailRules{AllowedDomains: []string{"xn--mnchen-3ya.de"}}
+	assert.Error(t, withoutIDN.Validate("user@münchen.de"), "raw Unicode domain should not match without IDN")
+}
+
+func TestEmailRules_IDNStrategyValidation(t *testing.T) {
+	rules := &EmailRules{IDN: true, Mode: ModeHTML5}
+	assert.NoError(t, rules.Validate("user@münchen.de"))
+
+	withoutIDN := &EmailRules{Mode: ModeHTML5}
+	assert.Error(t, withoutIDN.Validate("user@münchen.de"))
+}
+
+type stubEmailResolver struct {
+	mx      []*net.MX
+	mxErr   error
+	hostErr error
+}
+
+func (s stubEmailResolver) LookupMX(string) ([]*net.MX, error)  { return s.mx, s.mxErr }
+func (s stubEmailResolver) LookupHost(string) ([]string, error) { return nil, s.hostErr }
+
+func TestEmailRules_CheckMX(t *testing.T) {
+	withMX := &EmailRules{
+		CheckMX:  true,
+		Resolver: stubEmailResolver{mx: []*net.MX{{Host: "mail.example.com"}}},
+	}
+	assert.NoError(t, withMX.Validate("user@example.com"))
+
+	noMXWithFallback := &EmailRules{
+		CheckMX:  true,
+		Resolver: stubEmailResolver{mxErr: errors.New("no mx"), hostErr: nil},
+	}
+	assert.NoError(t, noMXWithFallback.Validate("user@example.com"))
+
+	undeliverable := &EmailRules{
+		CheckMX:  true,
+		Resolver: stubEmailResolver{mxErr: errors.New("no mx"), hostErr: errors.New("no such host")},
+	}
+	assert.Error(t, undeliverable.Validate("user@example.invalid"))
+}
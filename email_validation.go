@@ -19,7 +19,7 @@ const (
 
 func validateStrategy(strategy EmailValidationStrategy) bool {
 	switch strategy {
-	case RFC5321, RFC5322, HTMLInput:
+	case RFC5321, RFC5322, HTMLInput, RFC6531:
 		return true
 	default:
 		return false
@@ -38,6 +38,8 @@ func validateEmail(email string, strategy EmailValidationStrategy) bool {
 		return validateEmailRFC5322(email)
 	case HTMLInput:
 		return validateEmailHTML(email)
+	case RFC6531:
+		return validateEmailRFC6531(email)
 	default:
 		return validateEmailRFC5322(email)
 	}
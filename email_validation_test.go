@@ -78,6 +78,62 @@ func TestEmailValidation(t *testing.T) {
 			want:     true,
 		},
 
+		// RFC 6531 (SMTPUTF8) tests
+		{
+			name:     "RFC6531: ASCII email still valid",
+			email:    "user@example.com",
+			strategy: RFC6531,
+			want:     true,
+		},
+		{
+			name:     "RFC6531: Cyrillic domain",
+			email:    "user@пример.рф",
+			strategy: RFC6531,
+			want:     true,
+		},
+		{
+			name:     "RFC6531: Greek local part and domain",
+			email:    "δοκιμή@παράδειγμα.ελ",
+			strategy: RFC6531,
+			want:     true,
+		},
+		{
+			name:     "RFC6531: CJK local part",
+			email:    "田中太郎@example.com",
+			strategy: RFC6531,
+			want:     true,
+		},
+		{
+			name:     "RFC6531: RTL local part and domain",
+			email:    "مثال@مثال.مصر",
+			strategy: RFC6531,
+			want:     true,
+		},
+		{
+			name:     "RFC6531: hyphen-malformed domain label still rejected",
+			email:    "user@-bad-.com",
+			strategy: RFC6531,
+			want:     false,
+		},
+		{
+			name:     "RFC6531: leading dot in local part rejected",
+			email:    ".user@example.com",
+			strategy: RFC6531,
+			want:     false,
+		},
+		{
+			name:     "RFC6531: space in local part rejected",
+			email:    "user name@example.com",
+			strategy: RFC6531,
+			want:     false,
+		},
+		{
+			name:     "RFC6531: missing TLD rejected",
+			email:    "user@localhost",
+			strategy: RFC6531,
+			want:     false,
+		},
+
 		// common invalid cases for all strategies
 		{
 			name:     "Common: empty string",
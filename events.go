@@ -0,0 +1,171 @@
+package sval
+
+import (
+	"context"
+	"sync"
+)
+
+// EventTopic names a well-defined point in the validation lifecycle that
+// subscribers can observe.
+type EventTopic string
+
+const (
+	// EventBeforeField fires right before a leaf RuleSet runs.
+	EventBeforeField EventTopic = "before_field"
+	// EventAfterField fires after a leaf RuleSet runs without error.
+	EventAfterField EventTopic = "after_field"
+	// EventRuleFailed fires once per FieldError produced by a leaf RuleSet.
+	EventRuleFailed EventTopic = "rule_failed"
+	// EventValidationCompleted fires once a top-level Validate/ValidateContext
+	// call has finished walking the whole value, regardless of outcome.
+	EventValidationCompleted EventTopic = "validation_completed"
+)
+
+// ValidationEvent describes a single occurrence at one of the EventTopic
+// points. Field, Rule, Reason and Value are only meaningful for the
+// per-field topics (before_field, after_field, rule_failed); they're left
+// at their zero value for validation_completed.
+type ValidationEvent struct {
+	Topic  EventTopic
+	Field  string
+	Rule   string
+	Reason Reason
+	Value  any
+}
+
+// EventHandler receives a ValidationEvent. ctx is whatever context the
+// triggering Validate/ValidateContext call ran under, so a handler can
+// honor the same cancellation/deadline as the validation itself.
+type EventHandler func(ctx context.Context, event ValidationEvent)
+
+// Subscription is returned by Subscribe and lets the caller stop receiving
+// events for that subscription.
+type Subscription struct {
+	unsubscribe func()
+}
+
+// Unsubscribe removes the subscription. Safe to call more than once.
+func (s *Subscription) Unsubscribe() {
+	if s == nil || s.unsubscribe == nil {
+		return
+	}
+	s.unsubscribe()
+}
+
+// eventBus dispatches ValidationEvents to subscribers on a bounded worker
+// pool, so a slow or blocking subscriber delays other subscribers instead of
+// the validation call that emitted the event. It outlives any single
+// Validate/ValidateContext call, unlike the per-call workerPool in
+// context_validate.go.
+type eventBus struct {
+	mu          sync.RWMutex
+	subscribers map[EventTopic][]*subscriber
+	nextID      uint64
+	sem         chan struct{}
+}
+
+type subscriber struct {
+	id uint64
+	fn EventHandler
+}
+
+func newEventBus(maxParallelism int) *eventBus {
+	if maxParallelism <= 0 {
+		maxParallelism = defaultMaxParallelism
+	}
+	return &eventBus{
+		subscribers: make(map[EventTopic][]*subscriber),
+		sem:         make(chan struct{}, maxParallelism),
+	}
+}
+
+func (b *eventBus) subscribe(topic EventTopic, fn EventHandler) *Subscription {
+	b.mu.Lock()
+	b.nextID++
+	id := b.nextID
+	b.subscribers[topic] = append(b.subscribers[topic], &subscriber{id: id, fn: fn})
+	b.mu.Unlock()
+
+	return &Subscription{unsubscribe: func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		subs := b.subscribers[topic]
+		for i, sub := range subs {
+			if sub.id == id {
+				b.subscribers[topic] = append(subs[:i:i], subs[i+1:]...)
+				return
+			}
+		}
+	}}
+}
+
+// emit dispatches event to every topic subscriber on its own goroutine,
+// bounded by the bus's worker slots, and stops starting new dispatches once
+// ctx is done so a cancelled Validate/ValidateContext call doesn't keep
+// subscribers busy past its own deadline.
+func (b *eventBus) emit(ctx context.Context, event ValidationEvent) {
+	b.mu.RLock()
+	subs := b.subscribers[event.Topic]
+	if len(subs) == 0 {
+		b.mu.RUnlock()
+		return
+	}
+	fns := make([]EventHandler, len(subs))
+	for i, sub := range subs {
+		fns[i] = sub.fn
+	}
+	b.mu.RUnlock()
+
+	for _, fn := range fns {
+		fn := fn
+		if ctx.Err() != nil {
+			return
+		}
+		go func() {
+			select {
+			case b.sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			defer func() { <-b.sem }()
+			fn(ctx, event)
+		}()
+	}
+}
+
+// Subscribe registers fn to run whenever topic is emitted, returning a
+// handle to later stop receiving those events. Handlers run asynchronously
+// on a bounded worker pool, so a slow subscriber can't block validation.
+func (v *validator) Subscribe(topic EventTopic, fn EventHandler) *Subscription {
+	if v.events == nil {
+		v.events = newEventBus(v.maxParallelism)
+	}
+	return v.events.subscribe(topic, fn)
+}
+
+// emit is a no-op when the validator has no subscribers yet, so Validate and
+// ValidateContext can call it unconditionally.
+func (v *validator) emit(ctx context.Context, event ValidationEvent) {
+	if v.events == nil {
+		return
+	}
+	v.events.emit(ctx, event)
+}
+
+// emitFieldFailures emits one EventRuleFailed per FieldError carried by fe,
+// e.g. so a metrics subscriber can count failures per rule rather than per
+// field.
+func (v *validator) emitFieldFailures(ctx context.Context, field string, fe *ValidationError) {
+	if v.events == nil {
+		return
+	}
+	for _, e := range fe.errs {
+		v.events.emit(ctx, ValidationEvent{
+			Topic:  EventRuleFailed,
+			Field:  field,
+			Rule:   e.Rule,
+			Reason: e.Reason,
+			Value:  e.Got,
+		})
+	}
+}
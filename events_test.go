@@ -0,0 +1,149 @@
+package sval
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidator_SubscribeRuleFailed(t *testing.T) {
+	v, err := NewValidatorFromConfig(ValidatorConfig{
+		Rules: map[string]RuleConfig{
+			"name": {
+				Type: "string",
+				Params: map[string]any{
+					"required": true,
+					"min_len":  3,
+				},
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	type TestStruct struct {
+		Name string `sval:"name"`
+	}
+
+	var mu sync.Mutex
+	var got []ValidationEvent
+
+	sub := v.Subscribe(EventRuleFailed, func(_ context.Context, e ValidationEvent) {
+		mu.Lock()
+		got = append(got, e)
+		mu.Unlock()
+	})
+	defer sub.Unsubscribe()
+
+	err = v.Validate(TestStruct{Name: "jo"})
+	assert.Error(t, err)
+
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(got) == 1
+	}, time.Second, time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, "name", got[0].Field)
+	assert.Equal(t, ReasonMinLen, got[0].Reason)
+}
+
+func TestValidator_SubscribeValidationCompleted(t *testing.T) {
+	v, err := NewValidatorFromConfig(ValidatorConfig{
+		Rules: map[string]RuleConfig{
+			"name": {Type: "string", Params: map[string]any{"required": true}},
+		},
+	})
+	assert.NoError(t, err)
+
+	type TestStruct struct {
+		Name string `sval:"name"`
+	}
+
+	done := make(chan struct{}, 1)
+	sub := v.Subscribe(EventValidationCompleted, func(_ context.Context, e ValidationEvent) {
+		done <- struct{}{}
+	})
+	defer sub.Unsubscribe()
+
+	assert.NoError(t, v.Validate(TestStruct{Name: "ok"}))
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("validation_completed event was never dispatched")
+	}
+}
+
+func TestValidator_Unsubscribe(t *testing.T) {
+	v, err := NewValidatorFromConfig(ValidatorConfig{
+		Rules: map[string]RuleConfig{
+			"name": {Type: "string", Params: map[string]any{"required": true}},
+		},
+	})
+	assert.NoError(t, err)
+
+	type TestStruct struct {
+		Name string `sval:"name"`
+	}
+
+	var calls int
+	var mu sync.Mutex
+	sub := v.Subscribe(EventValidationCompleted, func(_ context.Context, e ValidationEvent) {
+		mu.Lock()
+		calls++
+		mu.Unlock()
+	})
+
+	assert.NoError(t, v.Validate(TestStruct{Name: "ok"}))
+	sub.Unsubscribe()
+	assert.NoError(t, v.Validate(TestStruct{Name: "ok"}))
+
+	time.Sleep(50 * time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, 1, calls)
+}
+
+func TestValidator_ValidateContext_EmitsWithCancellableContext(t *testing.T) {
+	v, err := NewValidatorFromConfig(ValidatorConfig{
+		Rules: map[string]RuleConfig{
+			"name": {Type: "string", Params: map[string]any{"required": true}},
+		},
+	})
+	assert.NoError(t, err)
+
+	type TestStruct struct {
+		Name string `sval:"name"`
+	}
+
+	var gotCtx context.Context
+	var mu sync.Mutex
+	done := make(chan struct{}, 1)
+
+	sub := v.Subscribe(EventBeforeField, func(ctx context.Context, e ValidationEvent) {
+		mu.Lock()
+		gotCtx = ctx
+		mu.Unlock()
+		done <- struct{}{}
+	})
+	defer sub.Unsubscribe()
+
+	ctx := context.Background()
+	assert.NoError(t, v.ValidateContext(ctx, TestStruct{Name: "ok"}))
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("before_field event was never dispatched")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.NotNil(t, gotCtx)
+}
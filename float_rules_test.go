@@ -42,7 +42,7 @@ func TestFloatRules(t *testing.T) {
 			wantErr: true,
 			expected: func() error {
 				err := NewValidationError()
-				err.AddError(BaseRuleNameRequired, true, FieldIsRequired)
+				err.AddReasonError(BaseRuleNameRequired, ReasonRequired, true, nil, FieldIsRequired)
 				return err
 			}(),
 		},
@@ -59,7 +59,7 @@ func TestFloatRules(t *testing.T) {
 			wantErr: true,
 			expected: func() error {
 				err := NewValidationError()
-				err.AddError(FloatRuleNameMin, min, "value must be greater than or equal to min")
+				err.AddReasonError(FloatRuleNameMin, ReasonMin, min, -1.0, "value must be greater than or equal to min")
 				return err
 			}(),
 		},
@@ -76,10 +76,23 @@ func TestFloatRules(t *testing.T) {
 			wantErr: true,
 			expected: func() error {
 				err := NewValidationError()
-				err.AddError(FloatRuleNameMax, max, "value must be less than or equal to max")
+				err.AddReasonError(FloatRuleNameMax, ReasonMax, max, 101.0, "value must be less than or equal to max")
 				return err
 			}(),
 		},
+		{
+			name: "int coerces to float",
+			rules: FloatRules{
+				BaseRules: BaseRules{
+					Required: true,
+				},
+				Min: &min,
+				Max: &max,
+			},
+			input:    24, // int coerces to float64 via the NumberRules engine
+			wantErr:  false,
+			expected: nil,
+		},
 		{
 			name: "invalid type",
 			rules: FloatRules{
@@ -89,11 +102,11 @@ func TestFloatRules(t *testing.T) {
 				Min: nil,
 				Max: nil,
 			},
-			input:   24, // Int instead of float64
+			input:   "not a float",
 			wantErr: true,
 			expected: func() error {
 				err := NewValidationError()
-				err.AddError(BaseRuleNameType, TypeFloat, "value must be a float")
+				err.AddReasonError(BaseRuleNameType, ReasonType, TypeFloat, "not a float", "value must be a number")
 				return err
 			}(),
 		},
@@ -0,0 +1,138 @@
+package sval
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// defaultHIBPBaseURL is the public Have I Been Pwned range API. The SHA-1
+// prefix is appended directly to it.
+const defaultHIBPBaseURL = "https://api.pwnedpasswords.com/range/"
+
+// HIBPHTTPClient abstracts the HTTP call behind HIBPConfig, so tests can
+// stub the Pwned Passwords API instead of depending on real network access.
+// *http.Client satisfies this interface already.
+type HIBPHTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// HIBPConfig enables PasswordRules.HIBPCheck: the candidate password is
+// checked against the Have I Been Pwned range API using k-anonymity, so only
+// a 5-character SHA-1 prefix ever leaves the box, never the password itself.
+type HIBPConfig struct {
+	// BaseURL defaults to defaultHIBPBaseURL; the SHA-1 prefix is appended
+	// directly to it.
+	BaseURL string
+	// Client defaults to http.DefaultClient.
+	Client HIBPHTTPClient
+	// Timeout bounds each request. Zero means no timeout is applied beyond
+	// whatever Client itself enforces.
+	Timeout time.Duration
+	// MaxAllowedOccurrences is the breach count at or above which the
+	// password is rejected. Zero defaults to 1, rejecting any appearance.
+	MaxAllowedOccurrences int
+	// Offline skips the network call entirely and falls back to checking
+	// PasswordRules.Blacklist instead, for tests and air-gapped deployments.
+	Offline bool
+	// FailOpen lets validation succeed instead of surfacing a transport
+	// error when the API is unreachable.
+	FailOpen bool
+}
+
+// checkHIBP runs PasswordRules.HIBPCheck against val, returning a
+// *ValidationError carrying PasswordRuleNameBreached if val is found in the
+// breach corpus (or the Blacklist, in offline mode).
+func (r *PasswordRules) checkHIBP(val string) error {
+	cfg := r.HIBPCheck
+
+	if cfg.Offline {
+		if slices.Contains(r.Blacklist, val) {
+			return hibpBreachedError(val, 1)
+		}
+		return nil
+	}
+
+	sum := sha1.Sum([]byte(val))
+	hexSum := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := hexSum[:5], hexSum[5:]
+
+	baseURL := cfg.BaseURL
+	if baseURL == "" {
+		baseURL = defaultHIBPBaseURL
+	}
+
+	client := cfg.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	ctx := context.Background()
+	if cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cfg.Timeout)
+		defer cancel()
+	}
+
+	req, reqErr := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+prefix, nil)
+	if reqErr != nil {
+		return hibpTransportError(reqErr, cfg.FailOpen)
+	}
+
+	resp, doErr := client.Do(req)
+	if doErr != nil {
+		return hibpTransportError(doErr, cfg.FailOpen)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return hibpTransportError(fmt.Errorf("pwned passwords API returned status %d", resp.StatusCode), cfg.FailOpen)
+	}
+
+	maxAllowed := cfg.MaxAllowedOccurrences
+	if maxAllowed <= 0 {
+		maxAllowed = 1
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		suffixPart, countPart, found := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if !found || suffixPart != suffix {
+			continue
+		}
+
+		count, convErr := strconv.Atoi(countPart)
+		if convErr != nil {
+			continue
+		}
+
+		if count >= maxAllowed {
+			return hibpBreachedError(val, count)
+		}
+		break
+	}
+
+	return nil
+}
+
+func hibpBreachedError(val string, count int) *ValidationError {
+	err := NewValidationError()
+	err.AddError(PasswordRuleNameBreached, count, val, fmt.Sprintf("password found in %d known breaches", count))
+	return err
+}
+
+func hibpTransportError(transportErr error, failOpen bool) error {
+	if failOpen {
+		return nil
+	}
+	err := NewValidationError()
+	err.AddError(PasswordRuleNameBreached, nil, nil, "could not verify password against breach database: "+transportErr.Error())
+	return err
+}
@@ -0,0 +1,94 @@
+package sval
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type stubHIBPClient struct {
+	body       string
+	statusCode int
+	err        error
+}
+
+func (s stubHIBPClient) Do(req *http.Request) (*http.Response, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	status := s.statusCode
+	if status == 0 {
+		status = http.StatusOK
+	}
+	return &http.Response{
+		StatusCode: status,
+		Body:       io.NopCloser(strings.NewReader(s.body)),
+	}, nil
+}
+
+// "password" SHA-1 hex is 5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8, so the
+// range suffix is everything after the first 5 characters.
+const passwordHIBPSuffix = "1E4C9B93F3F0682250B6CF8331B7EE68FD8"
+
+func TestPasswordRules_HIBPCheck_Breached(t *testing.T) {
+	rules := PasswordRules{
+		HIBPCheck: &HIBPConfig{
+			Client: stubHIBPClient{body: passwordHIBPSuffix + ":3730968\n"},
+		},
+	}
+
+	err := rules.Validate("password")
+	assert.Error(t, err)
+}
+
+func TestPasswordRules_HIBPCheck_BelowThreshold(t *testing.T) {
+	rules := PasswordRules{
+		HIBPCheck: &HIBPConfig{
+			Client:                stubHIBPClient{body: passwordHIBPSuffix + ":5\n"},
+			MaxAllowedOccurrences: 10,
+		},
+	}
+
+	assert.NoError(t, rules.Validate("password"))
+}
+
+func TestPasswordRules_HIBPCheck_NoMatch(t *testing.T) {
+	rules := PasswordRules{
+		HIBPCheck: &HIBPConfig{
+			Client: stubHIBPClient{body: "0000000000000000000000000000000000:1\n"},
+		},
+	}
+
+	assert.NoError(t, rules.Validate("xK9$mQ2!vL7&pR4"))
+}
+
+func TestPasswordRules_HIBPCheck_TransportError(t *testing.T) {
+	failClosed := PasswordRules{
+		HIBPCheck: &HIBPConfig{
+			Client: stubHIBPClient{err: errors.New("connection refused")},
+		},
+	}
+	assert.Error(t, failClosed.Validate("whatever"))
+
+	failOpen := PasswordRules{
+		HIBPCheck: &HIBPConfig{
+			Client:   stubHIBPClient{err: errors.New("connection refused")},
+			FailOpen: true,
+		},
+	}
+	assert.NoError(t, failOpen.Validate("whatever"))
+}
+
+func TestPasswordRules_HIBPCheck_Offline(t *testing.T) {
+	rules := PasswordRules{
+		HIBPCheck: &HIBPConfig{Offline: true},
+		Blacklist: []string{"password123"},
+	}
+
+	assert.Error(t, rules.Validate("password123"))
+	assert.NoError(t, rules.Validate("mySecurePass123!"))
+}
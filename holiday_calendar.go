@@ -0,0 +1,187 @@
+package sval
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// HolidayCalendar reports whether t falls on a holiday, along with the
+// holiday's name, so TimeRules.Calendars can express recurring and
+// floating-date holidays (e.g. "last Monday of May", "Easter Monday")
+// instead of TimeRules.Holidays' flat list of one-off dates that has to be
+// re-entered every year.
+type HolidayCalendar interface {
+	IsHoliday(t time.Time) (bool, string)
+}
+
+// FixedDateHoliday recurs on the same month/day every year, e.g. January 1st
+// or December 25th.
+type FixedDateHoliday struct {
+	Name  string
+	Month time.Month
+	Day   int
+}
+
+func (h FixedDateHoliday) IsHoliday(t time.Time) (bool, string) {
+	if t.Month() == h.Month && t.Day() == h.Day {
+		return true, h.Name
+	}
+	return false, ""
+}
+
+// NthWeekdayHoliday recurs on the Nth occurrence of Weekday within Month,
+// e.g. the 4th Thursday of November (US Thanksgiving). A negative N counts
+// from the end of the month, so N == -1 means "the last such weekday".
+type NthWeekdayHoliday struct {
+	Name    string
+	Month   time.Month
+	Weekday time.Weekday
+	N       int
+}
+
+func (h NthWeekdayHoliday) IsHoliday(t time.Time) (bool, string) {
+	if t.Month() != h.Month || t.Weekday() != h.Weekday {
+		return false, ""
+	}
+
+	if h.N > 0 {
+		return (t.Day()-1)/7+1 == h.N, h.Name
+	}
+	if h.N < 0 {
+		daysInMonth := time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, t.Location()).Day()
+		fromEnd := (daysInMonth-t.Day())/7 + 1
+		return fromEnd == -h.N, h.Name
+	}
+	return false, ""
+}
+
+// EasterOffsetHoliday recurs Offset days relative to Western (Gregorian)
+// Easter Sunday, e.g. Offset: 1 for Easter Monday or Offset: -2 for Good
+// Friday.
+type EasterOffsetHoliday struct {
+	Name   string
+	Offset int
+}
+
+func (h EasterOffsetHoliday) IsHoliday(t time.Time) (bool, string) {
+	easter := gregorianEaster(t.Year())
+	target := easter.AddDate(0, 0, h.Offset)
+	if t.Year() == target.Year() && t.Month() == target.Month() && t.Day() == target.Day() {
+		return true, h.Name
+	}
+	return false, ""
+}
+
+// gregorianEaster computes the date of Western Easter Sunday for year using
+// the anonymous Gregorian algorithm (Meeus/Jones/Butcher).
+func gregorianEaster(year int) time.Time {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
+
+// ICSCalendar is a HolidayCalendar built from the VEVENT DTSTART dates in an
+// RFC 5545 (iCalendar) file, so an organization can ship a single ICS
+// export - e.g. a "US-Federal" or "DE-BY" calendar - instead of regenerating
+// a Holidays date list every year. It reads only the date part of each
+// DTSTART (an all-day YYYYMMDD value, or the YYYYMMDD before the "T" of a
+// date-time value); RRULE recurrences are not expanded.
+type ICSCalendar struct {
+	dates map[string]string // "YYYY-MM-DD" -> SUMMARY
+}
+
+// LoadICS parses an RFC 5545 .ics document's VEVENTs into an ICSCalendar.
+func LoadICS(data []byte) (*ICSCalendar, error) {
+	cal := &ICSCalendar{dates: make(map[string]string)}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	var date, summary string
+	inEvent := false
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "BEGIN:VEVENT":
+			inEvent = true
+			date, summary = "", ""
+		case line == "END:VEVENT":
+			if inEvent && date != "" {
+				cal.dates[date] = summary
+			}
+			inEvent = false
+		case !inEvent:
+			continue
+		case strings.HasPrefix(line, "DTSTART"):
+			parts := strings.SplitN(line, ":", 2)
+			if len(parts) != 2 {
+				continue
+			}
+			parsed, err := parseICSDate(parts[1])
+			if err != nil {
+				return nil, fmt.Errorf("holiday_calendar: %w", err)
+			}
+			date = parsed
+		case strings.HasPrefix(line, "SUMMARY"):
+			parts := strings.SplitN(line, ":", 2)
+			if len(parts) == 2 {
+				summary = parts[1]
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("holiday_calendar: %w", err)
+	}
+
+	return cal, nil
+}
+
+func parseICSDate(raw string) (string, error) {
+	digits := raw
+	if idx := strings.IndexByte(raw, 'T'); idx != -1 {
+		digits = raw[:idx]
+	}
+	if len(digits) != 8 {
+		return "", fmt.Errorf("invalid DTSTART value %q", raw)
+	}
+
+	year, err := strconv.Atoi(digits[0:4])
+	if err != nil {
+		return "", fmt.Errorf("invalid DTSTART value %q", raw)
+	}
+	month, err := strconv.Atoi(digits[4:6])
+	if err != nil {
+		return "", fmt.Errorf("invalid DTSTART value %q", raw)
+	}
+	day, err := strconv.Atoi(digits[6:8])
+	if err != nil {
+		return "", fmt.Errorf("invalid DTSTART value %q", raw)
+	}
+
+	return fmt.Sprintf("%04d-%02d-%02d", year, month, day), nil
+}
+
+func (c *ICSCalendar) IsHoliday(t time.Time) (bool, string) {
+	key := t.Format("2006-01-02")
+	name, ok := c.dates[key]
+	if !ok {
+		return false, ""
+	}
+	return true, name
+}
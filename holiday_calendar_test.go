@@ -0,0 +1,80 @@
+package sval
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFixedDateHoliday(t *testing.T) {
+	holiday := FixedDateHoliday{Name: "New Year's Day", Month: time.January, Day: 1}
+
+	ok, name := holiday.IsHoliday(time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC))
+	assert.True(t, ok)
+	assert.Equal(t, "New Year's Day", name)
+
+	ok, _ = holiday.IsHoliday(time.Date(2026, time.January, 2, 0, 0, 0, 0, time.UTC))
+	assert.False(t, ok)
+}
+
+func TestNthWeekdayHoliday(t *testing.T) {
+	thanksgiving := NthWeekdayHoliday{Name: "Thanksgiving", Month: time.November, Weekday: time.Thursday, N: 4}
+	ok, _ := thanksgiving.IsHoliday(time.Date(2026, time.November, 26, 0, 0, 0, 0, time.UTC))
+	assert.True(t, ok)
+	ok, _ = thanksgiving.IsHoliday(time.Date(2026, time.November, 19, 0, 0, 0, 0, time.UTC))
+	assert.False(t, ok)
+
+	lastMonday := NthWeekdayHoliday{Name: "Memorial Day", Month: time.May, Weekday: time.Monday, N: -1}
+	ok, _ = lastMonday.IsHoliday(time.Date(2026, time.May, 25, 0, 0, 0, 0, time.UTC))
+	assert.True(t, ok)
+	ok, _ = lastMonday.IsHoliday(time.Date(2026, time.May, 18, 0, 0, 0, 0, time.UTC))
+	assert.False(t, ok)
+}
+
+func TestEasterOffsetHoliday(t *testing.T) {
+	goodFriday := EasterOffsetHoliday{Name: "Good Friday", Offset: -2}
+	easterMonday := EasterOffsetHoliday{Name: "Easter Monday", Offset: 1}
+
+	ok, _ := goodFriday.IsHoliday(time.Date(2026, time.April, 3, 0, 0, 0, 0, time.UTC))
+	assert.True(t, ok)
+	ok, _ = easterMonday.IsHoliday(time.Date(2026, time.April, 6, 0, 0, 0, 0, time.UTC))
+	assert.True(t, ok)
+	ok, _ = easterMonday.IsHoliday(time.Date(2026, time.April, 7, 0, 0, 0, 0, time.UTC))
+	assert.False(t, ok)
+}
+
+func TestLoadICS(t *testing.T) {
+	ics := []byte("BEGIN:VCALENDAR\n" +
+		"BEGIN:VEVENT\n" +
+		"DTSTART;VALUE=DATE:20260704\n" +
+		"SUMMARY:Independence Day\n" +
+		"END:VEVENT\n" +
+		"END:VCALENDAR\n")
+
+	cal, err := LoadICS(ics)
+	assert.NoError(t, err)
+
+	ok, name := cal.IsHoliday(time.Date(2026, time.July, 4, 0, 0, 0, 0, time.UTC))
+	assert.True(t, ok)
+	assert.Equal(t, "Independence Day", name)
+
+	ok, _ = cal.IsHoliday(time.Date(2026, time.July, 5, 0, 0, 0, 0, time.UTC))
+	assert.False(t, ok)
+}
+
+func TestLoadICS_InvalidDate(t *testing.T) {
+	_, err := LoadICS([]byte("BEGIN:VEVENT\nDTSTART:notadate\nEND:VEVENT\n"))
+	assert.Error(t, err)
+}
+
+func TestTimeRules_Calendars(t *testing.T) {
+	rules := TimeRules{
+		Calendars: []HolidayCalendar{
+			FixedDateHoliday{Name: "New Year's Day", Month: time.January, Day: 1},
+		},
+	}
+
+	assert.Error(t, rules.Validate(time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC).Format(time.RFC3339)))
+	assert.NoError(t, rules.Validate(time.Date(2026, time.January, 2, 0, 0, 0, 0, time.UTC).Format(time.RFC3339)))
+}
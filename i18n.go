@@ -0,0 +1,315 @@
+package sval
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Translator renders a human-readable message for a rule failure. ruleValues
+// is whatever was passed to ValidationError.AddError as the rule's constraint
+// (e.g. a min length, an allowed-domains list) and locale is a BCP-47-ish tag
+// such as "en" or "ru".
+type Translator interface {
+	Translate(ruleName string, ruleValues any, locale string) string
+}
+
+// localeCatalog maps a rule name (one of the *RuleName constants) to a
+// message template with at most one %v verb for ruleValues; a template
+// without one is returned as-is.
+type localeCatalog map[string]string
+
+var (
+	catalogsMu sync.RWMutex
+	catalogs   = map[string]localeCatalog{
+		"en": enCatalog,
+		"ru": ruCatalog,
+	}
+)
+
+// RegisterLocale adds or replaces a locale's catalog so downstream users can
+// ship additional languages without forking the module.
+func RegisterLocale(locale string, templates map[string]string) {
+	catalogsMu.Lock()
+	defer catalogsMu.Unlock()
+	catalogs[locale] = templates
+}
+
+// DefaultTranslator renders messages from the built-in (plus any
+// RegisterLocale-added) catalogs. It returns "" when the locale or rule name
+// isn't known, so callers fall back to the rule's original English message.
+type DefaultTranslator struct{}
+
+func (DefaultTranslator) Translate(ruleName string, ruleValues any, locale string) string {
+	catalogsMu.RLock()
+	catalog, ok := catalogs[locale]
+	catalogsMu.RUnlock()
+	if !ok {
+		return ""
+	}
+
+	tmpl, ok := catalog[ruleName]
+	if !ok {
+		return ""
+	}
+
+	if !strings.Contains(tmpl, "%v") {
+		return tmpl
+	}
+	return fmt.Sprintf(tmpl, ruleValues)
+}
+
+var enCatalog = localeCatalog{
+	BaseRuleNameRequired: "field is required",
+	BaseRuleNameType:     "value is of an unsupported type",
+
+	StringRuleNameMinLen:       "must be at least %v characters long",
+	StringRuleNameMaxLen:       "must be at most %v characters long",
+	StringRuleNameRegex:        "does not match the required pattern",
+	StringRuleNameOnlyDigits:   "must contain only digits",
+	StringRuleNameOnlyLetters:  "must contain only letters",
+	StringRuleNameNoWhitespace: "must not contain whitespace",
+	StringRuleNameStartsWith:   "must start with %v",
+	StringRuleNameEndsWith:     "must end with %v",
+	StringRuleNameContains:     "must contain %v",
+	StringRuleNameNotContains:  "must not contain %v",
+	StringRuleNameOneOf:        "must be one of %v",
+	StringRuleNameMinEntropy:   "entropy is too low, minimum is %v",
+
+	// EmailRuleNameExcludedDomains/EmailRuleNameRegexp share their literal
+	// value ("excluded_domains"/"regex") with URI and String respectively,
+	// and use the same message in every locale, so one catalog entry (above,
+	// under StringRuleNameRegex; below, under URIRuleNameExcludedDomains)
+	// already covers them - a second entry with the same key would be a
+	// duplicate map key and fail to compile.
+	EmailRuleNameStrategy:       "does not conform to the %v validation strategy",
+	EmailRuleNameMode:           "does not conform to the %v email mode",
+	EmailRuleNameMinDomainLen:   "domain part must be at least %v characters long",
+	EmailRuleNameAllowedDomains: "domain is not in the allowed list",
+	EmailRuleNameBlockedDomains: "domain is blocked",
+	EmailRuleNameRequireTLD:     "domain must include a top-level domain",
+	EmailRuleNameMaxLocalLen:    "local part must be at most %v characters long",
+	EmailRuleNameMaxTotalLen:    "must be at most %v characters long",
+	EmailRuleNameCheckMX:        "domain has no MX or A/AAAA record",
+
+	// PasswordRuleNameMinLen/MaxLen/MinEntropy share their literal values
+	// with the StringRuleName equivalents; see the comment above
+	// EmailRuleNameStrategy.
+	PasswordRuleNameMinUpper:             "must contain at least %v uppercase characters",
+	PasswordRuleNameMinLower:             "must contain at least %v lowercase characters",
+	PasswordRuleNameMinNumbers:           "must contain at least %v digits",
+	PasswordRuleNameMinSpecial:           "must contain at least %v special characters",
+	PasswordRuleNameSpecialChars:         "must use an allowed special character",
+	PasswordRuleNameAllowedChars:         "contains a character outside the allowed set",
+	PasswordRuleNameDisallowedChars:      "contains a disallowed character",
+	PasswordRuleNameMaxRepeatRun:         "has too many consecutive identical characters",
+	PasswordRuleNameDetectLinearPatterns: "contains a linear/keyboard pattern",
+	PasswordRuleNameBlacklist:            "is a commonly used password",
+	PasswordRuleNameMinGuessesLog10:      "is too guessable, minimum log10(guesses) is %v",
+
+	// FloatRuleNameMin/Max and NumberRuleNameMin/Max share their literal
+	// values with these; see the comment above EmailRuleNameStrategy.
+	IntRuleNameMin:        "must be greater than or equal to %v",
+	IntRuleNameMax:        "must be less than or equal to %v",
+	NumberRuleNameNonZero: "must not be zero",
+
+	IPRuleNameVersion:         "must be a valid IPv%v address",
+	IPRuleNameAllowPrivate:    "private or link-local IPs are not allowed",
+	IPRuleNameAllowedSubnets:  "must be within one of the allowed subnets",
+	IPRuleNameExcludedSubnets: "must not be within an excluded subnet",
+
+	MACRuleNameFormat:     "does not match the required format",
+	MACRuleNameCase:       "does not match the required case",
+	MACRuleNameType:       "does not match the required address type",
+	MACRuleNameOUI:        "OUI is not in the allowed list",
+	MACRuleNameVendor:     "vendor is not in the allowed list",
+	MACRuleNameAllowZero:  "the zero MAC address is not allowed",
+	MACRuleNameAllowBroad: "the broadcast MAC address is not allowed",
+	MACRuleNameAllowMulti: "multicast MAC addresses are not allowed",
+	MACRuleNameMaxOctets:  "has more than %v octets",
+
+	TimeRuleNameMinDate:       "must be after %v",
+	TimeRuleNameMaxDate:       "must be before %v",
+	TimeRuleNameFormats:       "does not match any of the accepted formats",
+	TimeRuleNameTimezones:     "timezone could not be resolved",
+	TimeRuleNameBeforeNow:     "must be before the current time",
+	TimeRuleNameAfterNow:      "must be after the current time",
+	TimeRuleNameWorkday:       "must fall on a workday",
+	TimeRuleNameWeekdays:      "must fall on one of the allowed weekdays",
+	TimeRuleNameRelativeRange: "is outside the allowed relative range",
+	TimeRuleNameHolidays:      "must not be a holiday",
+	TimeRuleNameBusinessHrs:   "is outside business hours",
+	TimeRuleNameSchedule:      "is outside the allowed weekly schedule",
+
+	CrossFieldRuleNameEqField:      "must equal field %v",
+	CrossFieldRuleNameGtField:      "must be greater than field %v",
+	CrossFieldRuleNameLtField:      "must be less than field %v",
+	CrossFieldRuleNameMatchesField: "must match field %v",
+
+	StringRuleNameTrimSpace: "must not have leading or trailing whitespace",
+	StringRuleNameConfig:    "rule configuration is invalid: %v",
+
+	PasswordRuleNameBreached: "appears in %v known data breaches",
+
+	NumberRuleNameExclusiveMin:  "must be strictly greater than %v",
+	NumberRuleNameExclusiveMax:  "must be strictly less than %v",
+	NumberRuleNameMultipleOf:    "must be a multiple of %v",
+	NumberRuleNameAllowedValues: "must be one of %v",
+
+	IPRuleNameAllowLoopback:    "loopback IPs are not allowed",
+	IPRuleNameAllowLinkLocal:   "link-local IPs are not allowed",
+	IPRuleNameAllowMulticast:   "multicast IPs are not allowed",
+	IPRuleNameAllowUnspecified: "the unspecified address is not allowed",
+
+	CIDRRuleNameMinPrefixLen:    "prefix length must be at least %v",
+	CIDRRuleNameMaxPrefixLen:    "prefix length must be at most %v",
+	CIDRRuleNameRequireCanon:    "must be in canonical form",
+	CIDRRuleNameRequireHostZero: "host bits must be zero",
+
+	URLRuleNameAllowedSchemes:   "scheme must be one of %v",
+	URLRuleNameRequireHost:      "must include a host",
+	URLRuleNameAllowedTLDs:      "top-level domain must be one of %v",
+	URLRuleNameDisallowUserinfo: "must not contain userinfo",
+	URLRuleNameResolveDNS:       "host does not resolve",
+
+	// DNSRuleNamePermittedDomains/DNSRuleNameExcludedDomains and
+	// URLRuleNameMaxLen share their literal values with URIRuleName/
+	// StringRuleName equivalents above; see the comment above
+	// EmailRuleNameStrategy.
+	URIRuleNamePermittedDomains: "domain is not in the permitted list",
+	URIRuleNameExcludedDomains:  "domain is excluded",
+
+	EmailRuleNamePermittedAddrs: "address is not in the permitted list",
+	EmailRuleNameExcludedAddrs:  "address is excluded",
+
+	TimeRuleNameMinTime: "time of day must be after %v",
+	TimeRuleNameMaxTime: "time of day must be before %v",
+
+	PostcodeRuleNameCountry: "country %v is not supported or not allowed",
+	PostcodeRuleNameFormat:  "is not a valid %v postcode",
+
+	BaseRuleNameRequiredIf:     "is required when %v",
+	BaseRuleNameRequiredUnless: "is required unless %v",
+	BaseRuleNameRequiredWith:   "is required when %v is present",
+	BaseRuleNameExcludedWith:   "must not be set when %v is present",
+}
+
+var ruCatalog = localeCatalog{
+	BaseRuleNameRequired: "поле обязательно для заполнения",
+	BaseRuleNameType:     "значение имеет неподдерживаемый тип",
+
+	StringRuleNameMinLen:       "должно быть не короче %v символов",
+	StringRuleNameMaxLen:       "должно быть не длиннее %v символов",
+	StringRuleNameRegex:        "не соответствует требуемому шаблону",
+	StringRuleNameOnlyDigits:   "должно содержать только цифры",
+	StringRuleNameOnlyLetters:  "должно содержать только буквы",
+	StringRuleNameNoWhitespace: "не должно содержать пробельные символы",
+	StringRuleNameStartsWith:   "должно начинаться с %v",
+	StringRuleNameEndsWith:     "должно заканчиваться на %v",
+	StringRuleNameContains:     "должно содержать %v",
+	StringRuleNameNotContains:  "не должно содержать %v",
+	StringRuleNameOneOf:        "должно быть одним из %v",
+	StringRuleNameMinEntropy:   "энтропия слишком низкая, минимум %v",
+
+	EmailRuleNameStrategy:       "не соответствует выбранной стратегии валидации %v",
+	EmailRuleNameMode:           "не соответствует режиму валидации email %v",
+	EmailRuleNameMinDomainLen:   "доменная часть должна быть не короче %v символов",
+	EmailRuleNameAllowedDomains: "домен отсутствует в списке разрешённых",
+	EmailRuleNameBlockedDomains: "домен заблокирован",
+	EmailRuleNameRequireTLD:     "домен должен содержать домен верхнего уровня",
+	EmailRuleNameMaxLocalLen:    "локальная часть должна быть не длиннее %v символов",
+	EmailRuleNameMaxTotalLen:    "должно быть не длиннее %v символов",
+	EmailRuleNameCheckMX:        "у домена нет записи MX или A/AAAA",
+
+	PasswordRuleNameMinUpper:             "должен содержать не менее %v заглавных букв",
+	PasswordRuleNameMinLower:             "должен содержать не менее %v строчных букв",
+	PasswordRuleNameMinNumbers:           "должен содержать не менее %v цифр",
+	PasswordRuleNameMinSpecial:           "должен содержать не менее %v спецсимволов",
+	PasswordRuleNameSpecialChars:         "должен использовать разрешённый спецсимвол",
+	PasswordRuleNameAllowedChars:         "содержит символ вне разрешённого набора",
+	PasswordRuleNameDisallowedChars:      "содержит запрещённый символ",
+	PasswordRuleNameMaxRepeatRun:         "содержит слишком много повторяющихся подряд символов",
+	PasswordRuleNameDetectLinearPatterns: "содержит последовательный/клавиатурный паттерн",
+	PasswordRuleNameBlacklist:            "входит в список распространённых паролей",
+	PasswordRuleNameMinGuessesLog10:      "слишком легко угадывается, минимум log10(попыток) %v",
+
+	IntRuleNameMin:        "должно быть больше или равно %v",
+	IntRuleNameMax:        "должно быть меньше или равно %v",
+	NumberRuleNameNonZero: "не должно быть равно нулю",
+
+	IPRuleNameVersion:         "должен быть корректным IPv%v адресом",
+	IPRuleNameAllowPrivate:    "приватные и локальные адреса не разрешены",
+	IPRuleNameAllowedSubnets:  "должен входить в одну из разрешённых подсетей",
+	IPRuleNameExcludedSubnets: "не должен входить в исключённую подсеть",
+
+	MACRuleNameFormat:     "не соответствует требуемому формату",
+	MACRuleNameCase:       "не соответствует требуемому регистру",
+	MACRuleNameType:       "не соответствует требуемому типу адреса",
+	MACRuleNameOUI:        "OUI отсутствует в списке разрешённых",
+	MACRuleNameVendor:     "производитель отсутствует в списке разрешённых",
+	MACRuleNameAllowZero:  "нулевой MAC-адрес не разрешён",
+	MACRuleNameAllowBroad: "широковещательный MAC-адрес не разрешён",
+	MACRuleNameAllowMulti: "multicast MAC-адреса не разрешены",
+	MACRuleNameMaxOctets:  "содержит больше %v октетов",
+
+	TimeRuleNameMinDate:       "должно быть позже %v",
+	TimeRuleNameMaxDate:       "должно быть раньше %v",
+	TimeRuleNameFormats:       "не соответствует ни одному из допустимых форматов",
+	TimeRuleNameTimezones:     "не удалось определить часовой пояс",
+	TimeRuleNameBeforeNow:     "должно быть раньше текущего времени",
+	TimeRuleNameAfterNow:      "должно быть позже текущего времени",
+	TimeRuleNameWorkday:       "должно приходиться на рабочий день",
+	TimeRuleNameWeekdays:      "должно приходиться на один из разрешённых дней недели",
+	TimeRuleNameRelativeRange: "выходит за пределы допустимого относительного диапазона",
+	TimeRuleNameHolidays:      "не должно приходиться на праздничный день",
+	TimeRuleNameBusinessHrs:   "вне рабочих часов",
+	TimeRuleNameSchedule:      "вне разрешённого еженедельного расписания",
+
+	CrossFieldRuleNameEqField:      "должно совпадать с полем %v",
+	CrossFieldRuleNameGtField:      "должно быть больше поля %v",
+	CrossFieldRuleNameLtField:      "должно быть меньше поля %v",
+	CrossFieldRuleNameMatchesField: "должно совпадать с полем %v",
+
+	StringRuleNameTrimSpace: "не должно содержать пробелы в начале или конце",
+	StringRuleNameConfig:    "некорректная конфигурация правила: %v",
+
+	PasswordRuleNameBreached: "найден в %v известных утечках данных",
+
+	NumberRuleNameExclusiveMin:  "должно быть строго больше %v",
+	NumberRuleNameExclusiveMax:  "должно быть строго меньше %v",
+	NumberRuleNameMultipleOf:    "должно быть кратно %v",
+	NumberRuleNameAllowedValues: "должно быть одним из %v",
+
+	IPRuleNameAllowLoopback:    "loopback-адреса не разрешены",
+	IPRuleNameAllowLinkLocal:   "link-local адреса не разрешены",
+	IPRuleNameAllowMulticast:   "multicast-адреса не разрешены",
+	IPRuleNameAllowUnspecified: "неопределённый адрес не разрешён",
+
+	CIDRRuleNameMinPrefixLen:    "длина префикса должна быть не менее %v",
+	CIDRRuleNameMaxPrefixLen:    "длина префикса должна быть не более %v",
+	CIDRRuleNameRequireCanon:    "должно быть в канонической форме",
+	CIDRRuleNameRequireHostZero: "биты узла должны быть равны нулю",
+
+	URLRuleNameAllowedSchemes:   "схема должна быть одной из %v",
+	URLRuleNameRequireHost:      "должен содержать хост",
+	URLRuleNameAllowedTLDs:      "домен верхнего уровня должен быть одним из %v",
+	URLRuleNameDisallowUserinfo: "не должен содержать userinfo",
+	URLRuleNameResolveDNS:       "хост не резолвится",
+
+	URIRuleNamePermittedDomains: "домен отсутствует в списке разрешённых",
+	URIRuleNameExcludedDomains:  "домен исключён",
+
+	EmailRuleNamePermittedAddrs: "адрес отсутствует в списке разрешённых",
+	EmailRuleNameExcludedAddrs:  "адрес исключён",
+
+	TimeRuleNameMinTime: "время суток должно быть позже %v",
+	TimeRuleNameMaxTime: "время суток должно быть раньше %v",
+
+	PostcodeRuleNameCountry: "страна %v не поддерживается или не разрешена",
+	PostcodeRuleNameFormat:  "не является корректным почтовым индексом для %v",
+
+	BaseRuleNameRequiredIf:     "обязательно, если %v",
+	BaseRuleNameRequiredUnless: "обязательно, если не %v",
+	BaseRuleNameRequiredWith:   "обязательно, если указано %v",
+	BaseRuleNameExcludedWith:   "не должно быть указано вместе с %v",
+}
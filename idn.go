@@ -0,0 +1,23 @@
+package sval
+
+import (
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+// toASCIIDomain converts domain to its IDNA ASCII (punycode) "A-label" form
+// using the Lookup profile, so a Unicode domain like "münchen.de" and its
+// already-ASCII form "xn--mnchen-3ya.de" compare equal. Domains that fail
+// IDNA conversion (already-invalid labels) fall back to a lowercase copy of
+// domain rather than being rejected outright.
+//
+// This is shared by EmailRules.IDN and is meant to be reused by any future
+// URL/host rule that needs the same normalization.
+func toASCIIDomain(domain string) string {
+	ascii, err := idna.Lookup.ToASCII(domain)
+	if err != nil {
+		return strings.ToLower(domain)
+	}
+	return ascii
+}
@@ -7,6 +7,9 @@ const (
 	IntRuleNameMax IntRuleName = "max"
 )
 
+// IntRules validates int values. It is a thin, backward-compatible
+// instantiation of NumberRules[int]; new code that needs int64, uint, or
+// other numeric widths should use NumberRules directly.
 type IntRules struct {
 	BaseRules
 	Min *int `json:"min" yaml:"min"`
@@ -14,41 +17,10 @@ type IntRules struct {
 }
 
 func (r *IntRules) Validate(i any) error {
-	err := NewValidationError()
-
-	if i == nil {
-		if r.Required {
-			err.AddError(BaseRuleNameRequired, r.Required, i, FieldIsRequired)
-		}
-		return err
-	}
-
-	if ptr, ok := i.(*int); ok {
-		if ptr == nil {
-			if r.Required {
-				err.AddError(BaseRuleNameRequired, r.Required, i, FieldIsRequired)
-			}
-			return err
-		}
-	}
-
-	val, ok := i.(int)
-	if !ok {
-		err.AddError(BaseRuleNameType, TypeInt, i, "value must be int")
-		return err
-	}
-
-	if r.Min != nil && val < *r.Min {
-		err.AddError(IntRuleNameMin, *r.Min, i, "value must be greater than or equal to min")
-	}
-
-	if r.Max != nil && val > *r.Max {
-		err.AddError(IntRuleNameMax, *r.Max, i, "value must be less than or equal to max")
+	rules := NumberRules[int]{
+		BaseRules: r.BaseRules,
+		Min:       r.Min,
+		Max:       r.Max,
 	}
-
-	if err.HasErrors() {
-		return err
-	}
-
-	return nil
+	return rules.Validate(i)
 }
@@ -42,7 +42,7 @@ func TestIntRules(t *testing.T) {
 			wantErr: true,
 			expected: func() error {
 				err := NewValidationError()
-				err.AddError(BaseRuleNameRequired, true, FieldIsRequired)
+				err.AddReasonError(BaseRuleNameRequired, ReasonRequired, true, nil, FieldIsRequired)
 				return err
 			}(),
 		},
@@ -59,7 +59,7 @@ func TestIntRules(t *testing.T) {
 			wantErr: true,
 			expected: func() error {
 				err := NewValidationError()
-				err.AddError(IntRuleNameMin, min, "value must be greater than or equal to min")
+				err.AddReasonError(IntRuleNameMin, ReasonMin, min, -1, "value must be greater than or equal to min")
 				return err
 			}(),
 		},
@@ -76,7 +76,7 @@ func TestIntRules(t *testing.T) {
 			wantErr: true,
 			expected: func() error {
 				err := NewValidationError()
-				err.AddError(IntRuleNameMax, max, "value must be less than or equal to max")
+				err.AddReasonError(IntRuleNameMax, ReasonMax, max, 101, "value must be less than or equal to max")
 				return err
 			}(),
 		},
@@ -93,10 +93,36 @@ func TestIntRules(t *testing.T) {
 			wantErr: true,
 			expected: func() error {
 				err := NewValidationError()
-				err.AddError(BaseRuleNameType, TypeInt, "value must be int")
+				err.AddReasonError(BaseRuleNameType, ReasonType, TypeInt, "not an int", "value must be a number")
 				return err
 			}(),
 		},
+		{
+			name: "coerces numeric string",
+			rules: IntRules{
+				BaseRules: BaseRules{
+					Required: true,
+				},
+				Min: &min,
+				Max: &max,
+			},
+			input:    "42",
+			wantErr:  false,
+			expected: nil,
+		},
+		{
+			name: "coerces pointer to int",
+			rules: IntRules{
+				BaseRules: BaseRules{
+					Required: true,
+				},
+				Min: &min,
+				Max: &max,
+			},
+			input:    ptr(42),
+			wantErr:  false,
+			expected: nil,
+		},
 	}
 
 	for _, tc := range testCases {
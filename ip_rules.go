@@ -3,23 +3,77 @@ package sval
 import (
 	"net"
 	"net/netip"
+	"sync"
 )
 
 type IPRuleName = string
 
 const (
-	IPRuleNameVersion         IPRuleName = "version"
-	IPRuleNameAllowPrivate    IPRuleName = "allow_private"
-	IPRuleNameAllowedSubnets  IPRuleName = "allowed_subnets"
-	IPRuleNameExcludedSubnets IPRuleName = "excluded_subnets"
+	IPRuleNameVersion          IPRuleName = "version"
+	IPRuleNameAllowPrivate     IPRuleName = "allow_private"
+	IPRuleNameAllowLoopback    IPRuleName = "allow_loopback"
+	IPRuleNameAllowLinkLocal   IPRuleName = "allow_link_local"
+	IPRuleNameAllowMulticast   IPRuleName = "allow_multicast"
+	IPRuleNameAllowUnspecified IPRuleName = "allow_unspecified"
+	IPRuleNameAllowedSubnets   IPRuleName = "allowed_subnets"
+	IPRuleNameExcludedSubnets  IPRuleName = "excluded_subnets"
 )
 
 type IPRules struct {
 	BaseRules
-	Version         int      `json:"version" yaml:"version"` // 4, 6 or 0 for both
-	AllowPrivate    bool     `json:"allow_private" yaml:"allow_private"`
+	Version      int  `json:"version" yaml:"version"` // 4, 6 or 0 for both
+	AllowPrivate bool `json:"allow_private" yaml:"allow_private"`
+	// AllowLoopback/AllowLinkLocal/AllowMulticast/AllowUnspecified default to
+	// permissive (nil, like an unset MACRules Allow* flag means "not
+	// checked") so an IPRules zero value keeps validating exactly like
+	// before these axes existed; set one to false to start rejecting that
+	// class of address.
+	AllowLoopback    *bool `json:"allow_loopback,omitempty" yaml:"allow_loopback"`
+	AllowLinkLocal   *bool `json:"allow_link_local,omitempty" yaml:"allow_link_local"`
+	AllowMulticast   *bool `json:"allow_multicast,omitempty" yaml:"allow_multicast"`
+	AllowUnspecified *bool `json:"allow_unspecified,omitempty" yaml:"allow_unspecified"`
+	// AllowedSubnets/ExcludedSubnets entries may be CIDR blocks or bare IP
+	// addresses (e.g. "192.168.1.5"), which compile() widens to a host-only
+	// /32 or /128 so they match like any other subnet.
 	AllowedSubnets  []string `json:"allowed_subnets" yaml:"allowed_subnets"`
 	ExcludedSubnets []string `json:"excluded_subnets" yaml:"excluded_subnets"`
+
+	// compileOnce guards allowedNets/excludedNets/compileErr so
+	// AllowedSubnets/ExcludedSubnets are parsed into compiledRanges exactly
+	// once instead of re-running net.ParseCIDR on every Validate call.
+	// ValidateContext (see context_validate.go) can drive the same *IPRules
+	// from multiple goroutines when it's attached to a slice field, so this
+	// can't be a plain memoized bool - sync.Once is what makes compile()
+	// safe under that concurrency model.
+	compileOnce     sync.Once
+	compileErr      error
+	compileErrField IPRuleName
+	allowedNets     compiledRanges
+	excludedNets    compiledRanges
+}
+
+// Compile pre-parses AllowedSubnets/ExcludedSubnets into compiledRanges so
+// Validate never calls net.ParseCIDR itself. It satisfies Compiler, so
+// NewValidatorFromConfig runs it (and surfaces a bad CIDR as a ConfigError)
+// right after loading instead of at the first Validate call.
+func (r *IPRules) Compile() error {
+	err, _ := r.compile()
+	return err
+}
+
+func (r *IPRules) compile() (error, IPRuleName) {
+	r.compileOnce.Do(func() {
+		r.allowedNets, r.compileErr = compileCIDRRanges(r.AllowedSubnets)
+		if r.compileErr != nil {
+			r.compileErrField = IPRuleNameAllowedSubnets
+			return
+		}
+		r.excludedNets, r.compileErr = compileCIDRRanges(r.ExcludedSubnets)
+		if r.compileErr != nil {
+			r.compileErrField = IPRuleNameExcludedSubnets
+		}
+	})
+	return r.compileErr, r.compileErrField
 }
 
 func (r *IPRules) Validate(i any) error {
@@ -27,32 +81,77 @@ func (r *IPRules) Validate(i any) error {
 
 	if i == nil {
 		if r.Required {
-			err.AddError(BaseRuleNameRequired, r.Required, i, FieldIsRequired)
+			err.AddReasonError(BaseRuleNameRequired, ReasonRequired, r.Required, i, FieldIsRequired)
 			return err
 		}
 		return nil
 	}
 
-	if ptr, ok := i.(*string); ok {
-		if ptr == nil {
+	switch v := i.(type) {
+	case *string:
+		if v == nil {
+			if r.Required {
+				err.AddReasonError(BaseRuleNameRequired, ReasonRequired, r.Required, i, FieldIsRequired)
+				return err
+			}
+			return nil
+		}
+		i = *v
+	case string:
+		if v == "" {
+			if r.Required {
+				err.AddReasonError(BaseRuleNameRequired, ReasonRequired, r.Required, i, FieldIsRequired)
+				return err
+			}
+			return nil
+		}
+	case net.IP:
+		if v == nil {
+			if r.Required {
+				err.AddReasonError(BaseRuleNameRequired, ReasonRequired, r.Required, i, FieldIsRequired)
+				return err
+			}
+			return nil
+		}
+		i = v.String()
+	case *net.IP:
+		if v == nil || *v == nil {
+			if r.Required {
+				err.AddReasonError(BaseRuleNameRequired, ReasonRequired, r.Required, i, FieldIsRequired)
+				return err
+			}
+			return nil
+		}
+		i = v.String()
+	case netip.Addr:
+		if !v.IsValid() {
+			if r.Required {
+				err.AddReasonError(BaseRuleNameRequired, ReasonRequired, r.Required, i, FieldIsRequired)
+				return err
+			}
+			return nil
+		}
+		i = v.String()
+	case *netip.Addr:
+		if v == nil || !v.IsValid() {
 			if r.Required {
-				err.AddError(BaseRuleNameRequired, r.Required, i, FieldIsRequired)
+				err.AddReasonError(BaseRuleNameRequired, ReasonRequired, r.Required, i, FieldIsRequired)
 				return err
 			}
 			return nil
 		}
-		i = *ptr
+		i = v.String()
 	}
 
 	val, ok := i.(string)
 	if !ok {
-		err.AddError(BaseRuleNameType, TypeIP, i, "value must be a string")
+		err.AddReasonError(BaseRuleNameType, ReasonType, TypeIP, i, "value must be a string, net.IP, or netip.Addr")
 		return err
 	}
 
 	if val == "" {
 		if r.Required {
-			err.AddError(BaseRuleNameRequired, r.Required, i, FieldIsRequired)
+			err.AddReasonError(BaseRuleNameRequired, ReasonRequired, r.Required, i, FieldIsRequired)
 			return err
 		}
 		return nil
@@ -60,56 +159,72 @@ func (r *IPRules) Validate(i any) error {
 
 	ip, errParse := netip.ParseAddr(val)
 	if errParse != nil {
-		err.AddError(BaseRuleNameType, TypeIP, i, "invalid IP address format")
+		err.AddReasonError(BaseRuleNameType, ReasonType, TypeIP, i, "invalid IP address format")
 		return err
 	}
 
 	if !r.validateVersion(ip) {
-		err.AddError(IPRuleNameVersion, r.Version, i, "IP version mismatch")
+		err.AddReasonError(IPRuleNameVersion, ReasonIPVersion, r.Version, i, "IP version mismatch")
 		return err
 	}
 
-	if !r.AllowPrivate && (ip.IsPrivate() || ip.IsLinkLocalUnicast()) {
-		err.AddError(IPRuleNameAllowPrivate, r.AllowPrivate, i, "private or link-local IPs are not allowed")
+	if errCompile, field := r.compile(); errCompile != nil {
+		subnets := r.AllowedSubnets
+		if field == IPRuleNameExcludedSubnets {
+			subnets = r.ExcludedSubnets
+		}
+		err.AddReasonError(field, ReasonConfig, subnets, i, "invalid subnet format: "+errCompile.Error())
 		return err
 	}
 
-	if len(r.AllowedSubnets) > 0 {
-		// TODO: separate after cli will be implemented.
-		// TEMP. In the future will be cli that will validate sval config files.
-		// So cli will validate that all allowed subnets are valid CIDR notations.
-		allowed := false
-		for _, subnet := range r.AllowedSubnets {
-			_, netIP, errParse := net.ParseCIDR(subnet)
-			if errParse != nil {
-				err.AddError(IPRuleNameAllowedSubnets, r.AllowedSubnets, i, "invalid allowed subnet format")
-				return err
-			}
-			if netIP.Contains(ip.AsSlice()) {
-				allowed = true
-				break
-			}
-		}
+	// allowedBySubnet tracks whether AllowedSubnets is configured and ip
+	// matched one of its entries - an explicit subnet allowlist (e.g. a
+	// corporate 10.0.0.0/8 CIDR) is a more specific grant than the blanket
+	// AllowPrivate flag below, so it must be checked first and, on a match,
+	// take precedence over that blanket rejection instead of being shadowed
+	// by it.
+	allowedBySubnet := false
+	if len(r.allowedNets) > 0 {
+		allowed, _ := matchIPConstraint(ip, r.allowedNets, nil)
 		if !allowed {
-			err.AddError(IPRuleNameAllowedSubnets, r.AllowedSubnets, i,
+			err.AddReasonError(IPRuleNameAllowedSubnets, ReasonSubnetNotAllowed, r.AllowedSubnets, i,
 				"IP is not in any of the allowed subnets")
 			return err
 		}
+		allowedBySubnet = true
 	}
 
-	if len(r.ExcludedSubnets) > 0 {
-		// TODO: same as above.
-		for _, subnet := range r.ExcludedSubnets {
-			_, netIP, errParse := net.ParseCIDR(subnet)
-			if errParse != nil {
-				err.AddError(IPRuleNameExcludedSubnets, r.ExcludedSubnets, i, "invalid excluded subnet format")
-				return err
-			}
-			if netIP.Contains(ip.AsSlice()) {
-				err.AddError(IPRuleNameExcludedSubnets, r.ExcludedSubnets, i,
-					"IP is in an excluded subnet")
-				return err
-			}
+	if !allowedBySubnet && !r.AllowPrivate && (ip.IsPrivate() || ip.IsLinkLocalUnicast()) {
+		err.AddReasonError(IPRuleNameAllowPrivate, ReasonPrivateNotAllowed, r.AllowPrivate, i, "private or link-local IPs are not allowed")
+		return err
+	}
+
+	if r.AllowLoopback != nil && !*r.AllowLoopback && ip.IsLoopback() {
+		err.AddReasonError(IPRuleNameAllowLoopback, ReasonLoopbackNotAllowed, *r.AllowLoopback, i, "loopback IPs are not allowed")
+		return err
+	}
+
+	if r.AllowLinkLocal != nil && !*r.AllowLinkLocal && ip.IsLinkLocalUnicast() {
+		err.AddReasonError(IPRuleNameAllowLinkLocal, ReasonLinkLocalNotAllowed, *r.AllowLinkLocal, i, "link-local IPs are not allowed")
+		return err
+	}
+
+	if r.AllowMulticast != nil && !*r.AllowMulticast && ip.IsMulticast() {
+		err.AddReasonError(IPRuleNameAllowMulticast, ReasonMulticastNotAllowed, *r.AllowMulticast, i, "multicast IPs are not allowed")
+		return err
+	}
+
+	if r.AllowUnspecified != nil && !*r.AllowUnspecified && ip.IsUnspecified() {
+		err.AddReasonError(IPRuleNameAllowUnspecified, ReasonUnspecifiedNotAllowed, *r.AllowUnspecified, i, "unspecified IP is not allowed")
+		return err
+	}
+
+	if len(r.excludedNets) > 0 {
+		allowed, _ := matchIPConstraint(ip, nil, r.excludedNets)
+		if !allowed {
+			err.AddReasonError(IPRuleNameExcludedSubnets, ReasonSubnetExcluded, r.ExcludedSubnets, i,
+				"IP is in an excluded subnet")
+			return err
 		}
 	}
 
@@ -277,6 +277,26 @@ func TestIPRules(t *testing.T) {
 			value:   "192.168.1.1",
 			wantErr: true,
 		},
+		{
+			name: "bare IP in allowed subnets matches",
+			rules: IPRules{
+				Version:        4,
+				AllowedSubnets: []string{"192.168.1.5"},
+				AllowPrivate:   true,
+			},
+			value:   "192.168.1.5",
+			wantErr: false,
+		},
+		{
+			name: "bare IP in allowed subnets does not match a neighbor",
+			rules: IPRules{
+				Version:        4,
+				AllowedSubnets: []string{"192.168.1.5"},
+				AllowPrivate:   true,
+			},
+			value:   "192.168.1.6",
+			wantErr: true,
+		},
 
 		// excluded subnets tests
 		{
@@ -355,9 +375,60 @@ func TestIPRules(t *testing.T) {
 			value:   "192.168.0.1",
 			wantErr: true,
 		},
+
+		// Allow*-flag tests (nil means permissive, matching pre-existing behavior)
+		{
+			name:    "loopback allowed by default",
+			rules:   IPRules{Version: 4},
+			value:   "127.0.0.1",
+			wantErr: false,
+		},
+		{
+			name:    "loopback rejected when disallowed",
+			rules:   IPRules{Version: 4, AllowLoopback: ptr(false)},
+			value:   "127.0.0.1",
+			wantErr: true,
+		},
+		{
+			name:    "loopback allowed when explicitly allowed",
+			rules:   IPRules{Version: 4, AllowLoopback: ptr(true)},
+			value:   "127.0.0.1",
+			wantErr: false,
+		},
+		{
+			name:    "link-local rejected when disallowed",
+			rules:   IPRules{Version: 6, AllowLinkLocal: ptr(false)},
+			value:   "fe80::1",
+			wantErr: true,
+		},
+		{
+			name:    "multicast allowed by default",
+			rules:   IPRules{Version: 4},
+			value:   "224.0.0.1",
+			wantErr: false,
+		},
+		{
+			name:    "multicast rejected when disallowed",
+			rules:   IPRules{Version: 4, AllowMulticast: ptr(false)},
+			value:   "224.0.0.1",
+			wantErr: true,
+		},
+		{
+			name:    "unspecified rejected when disallowed",
+			rules:   IPRules{Version: 4, AllowUnspecified: ptr(false)},
+			value:   "0.0.0.0",
+			wantErr: true,
+		},
+		{
+			name:    "unspecified allowed when explicitly allowed",
+			rules:   IPRules{Version: 4, AllowUnspecified: ptr(true)},
+			value:   "0.0.0.0",
+			wantErr: false,
+		},
 	}
 
-	for _, tt := range tests {
+	for i := range tests {
+		tt := &tests[i]
 		t.Run(tt.name, func(t *testing.T) {
 			f, ok := tt.value.(func() *netip.Addr)
 			if ok {
@@ -377,3 +448,24 @@ func TestIPRules(t *testing.T) {
 		})
 	}
 }
+
+func TestIPRules_Compile(t *testing.T) {
+	r := &IPRules{AllowedSubnets: []string{"10.0.0.0/8"}}
+	assert.NoError(t, r.Compile())
+	assert.NoError(t, r.Compile(), "Compile must be idempotent")
+
+	bad := &IPRules{ExcludedSubnets: []string{"not-a-cidr"}}
+	err := bad.Compile()
+	assert.Error(t, err)
+	assert.Equal(t, err, bad.Compile(), "Compile must cache and return the same error")
+}
+
+func TestIPRules_ReasonCodes(t *testing.T) {
+	rules := IPRules{Version: 4, AllowedSubnets: []string{"10.0.0.0/8"}}
+	err := rules.Validate("192.168.1.1")
+	assert.Error(t, err)
+
+	ve, ok := err.(*ValidationError)
+	assert.True(t, ok)
+	assert.Equal(t, ReasonSubnetNotAllowed, ve.First().Reason)
+}
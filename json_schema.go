@@ -0,0 +1,211 @@
+package sval
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+)
+
+// jsonSchema is a JSON Schema node, built up as a plain map so nested
+// properties/items can be merged in incrementally before the final marshal.
+type jsonSchema map[string]any
+
+// JSONSchema serializes the validator's configured rules into a Draft
+// 2020-12 JSON Schema document, so the same rule set can double as an
+// OpenAPI contract instead of being maintained twice. It is the inverse of
+// createRuleSet: that function turns config into runtime rules, this one
+// turns runtime rules back into a schema.
+func (v *validator) JSONSchema() ([]byte, error) {
+	root := jsonSchema{
+		"$schema":    "https://json-schema.org/draft/2020-12/schema",
+		"type":       "object",
+		"properties": jsonSchema{},
+	}
+
+	for path, ruleSet := range v.rules {
+		leaf := schemaForRuleSet(ruleSet)
+		insertSchema(root, path, leaf)
+	}
+
+	return json.MarshalIndent(root, "", "  ")
+}
+
+// schemaForRuleSet maps a concrete rule type to its schema fragment. Rule
+// types with no mapping below (e.g. MACRules) export a permissive {} schema
+// rather than failing the whole document.
+func schemaForRuleSet(rs RuleSet) jsonSchema {
+	if cond, ok := rs.(*conditionalRuleSet); ok {
+		rs = cond.inner
+	}
+
+	switch r := rs.(type) {
+	case *StringRules:
+		return stringRulesSchema(r)
+	case *IntRules:
+		return intRulesSchema(r)
+	case *FloatRules:
+		return floatRulesSchema(r)
+	case *EmailRules:
+		return emailRulesSchema(r)
+	case *TimeRules:
+		return timeRulesSchema(r)
+	case *anyOfRuleSet:
+		return jsonSchema{"anyOf": schemasForRuleSets(r.rules)}
+	case *allOfRuleSet:
+		return jsonSchema{"allOf": schemasForRuleSets(r.rules)}
+	case *oneOfRuleSet:
+		return jsonSchema{"oneOf": schemasForRuleSets(r.rules)}
+	case *notRuleSet:
+		return jsonSchema{"not": schemaForRuleSet(r.rule)}
+	default:
+		return jsonSchema{}
+	}
+}
+
+func schemasForRuleSets(rules []RuleSet) []jsonSchema {
+	schemas := make([]jsonSchema, len(rules))
+	for i, rs := range rules {
+		schemas[i] = schemaForRuleSet(rs)
+	}
+	return schemas
+}
+
+func stringRulesSchema(r *StringRules) jsonSchema {
+	s := jsonSchema{"type": "string"}
+
+	if r.MinLen > 0 {
+		s["minLength"] = r.MinLen
+	}
+	if r.MaxLen > 0 {
+		s["maxLength"] = r.MaxLen
+	}
+	if r.Regex != nil {
+		s["pattern"] = *r.Regex
+	}
+	if len(r.OneOf) > 0 {
+		s["enum"] = r.OneOf
+	}
+
+	return s
+}
+
+func intRulesSchema(r *IntRules) jsonSchema {
+	s := jsonSchema{"type": "integer"}
+
+	if r.Min != nil {
+		s["minimum"] = *r.Min
+	}
+	if r.Max != nil {
+		s["maximum"] = *r.Max
+	}
+
+	return s
+}
+
+func floatRulesSchema(r *FloatRules) jsonSchema {
+	s := jsonSchema{"type": "number"}
+
+	if r.Min != nil {
+		s["minimum"] = *r.Min
+	}
+	if r.Max != nil {
+		s["maximum"] = *r.Max
+	}
+
+	return s
+}
+
+func emailRulesSchema(r *EmailRules) jsonSchema {
+	s := jsonSchema{"type": "string", "format": "email"}
+
+	switch {
+	case len(r.AllowedDomains) > 0:
+		s["pattern"] = "^.+@(" + domainAlternation(r.AllowedDomains) + ")$"
+	case len(r.ExcludedDomains) > 0:
+		s["pattern"] = "^(?!.*@(" + domainAlternation(r.ExcludedDomains) + ")$).+$"
+	}
+
+	return s
+}
+
+func domainAlternation(domains []string) string {
+	escaped := make([]string, len(domains))
+	for i, d := range domains {
+		escaped[i] = regexp.QuoteMeta(d)
+	}
+	return strings.Join(escaped, "|")
+}
+
+func timeRulesSchema(r *TimeRules) jsonSchema {
+	s := jsonSchema{"type": "string"}
+	if len(r.Formats) == 0 || slicesContainsString(r.Formats, "rfc3339") {
+		s["format"] = "date-time"
+	}
+	return s
+}
+
+func slicesContainsString(haystack []string, needle string) bool {
+	for _, s := range haystack {
+		if strings.EqualFold(s, needle) {
+			return true
+		}
+	}
+	return false
+}
+
+// insertSchema walks a dotted/bracketed rule path (e.g. "users[].email") and
+// merges leaf into the schema node it addresses, creating "properties" and
+// "items" nodes along the way.
+func insertSchema(root jsonSchema, path string, leaf jsonSchema) {
+	segments := strings.Split(path, ".")
+	node := root
+
+	for i, seg := range segments {
+		isArray := strings.HasSuffix(seg, "[]")
+		name := strings.TrimSuffix(seg, "[]")
+
+		props, _ := node["properties"].(jsonSchema)
+		if props == nil {
+			props = jsonSchema{}
+			node["properties"] = props
+		}
+		if node["type"] == nil {
+			node["type"] = "object"
+		}
+
+		child, ok := props[name].(jsonSchema)
+		if !ok {
+			child = jsonSchema{}
+			props[name] = child
+		}
+
+		last := i == len(segments)-1
+
+		if isArray {
+			child["type"] = "array"
+			items, _ := child["items"].(jsonSchema)
+			if items == nil {
+				items = jsonSchema{}
+				child["items"] = items
+			}
+			if last {
+				mergeSchema(items, leaf)
+			} else {
+				node = items
+			}
+			continue
+		}
+
+		if last {
+			mergeSchema(child, leaf)
+		} else {
+			node = child
+		}
+	}
+}
+
+func mergeSchema(dst, src jsonSchema) {
+	for k, v := range src {
+		dst[k] = v
+	}
+}
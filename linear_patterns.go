@@ -0,0 +1,151 @@
+package sval
+
+import (
+	"slices"
+	"unicode"
+)
+
+// MinLinearRunLen is the default minimum run length DetectLinearPatterns
+// flags as a linear/keyboard sequence (e.g. "asdf" is 4 characters).
+const MinLinearRunLen = 4
+
+// LinearPatternGraph is a directed adjacency graph used by linear pattern
+// detection: each rune maps to the runes that may directly follow it in a
+// "linear" sequence (alphabetic order, digit order, or physical keyboard
+// adjacency). Graphs are exported, and LinearPatternGraphs can be extended,
+// so callers can register additional layouts.
+type LinearPatternGraph = map[rune][]rune
+
+// Built-in layouts. Each keyboard layout includes the digit row so patterns
+// like "1qaz" (top-to-home-row diagonal) are caught alongside same-row runs
+// like "qwerty" or "asdf".
+var (
+	AlphaLinearGraph  = buildSequentialGraph("abcdefghijklmnopqrstuvwxyz", true)
+	DigitLinearGraph  = buildSequentialGraph("0123456789", false)
+	QWERTYLinearGraph = buildKeyboardGraph([]string{"1234567890", "qwertyuiop", "asdfghjkl", "zxcvbnm"})
+	QWERTZLinearGraph = buildKeyboardGraph([]string{"1234567890", "qwertzuiop", "asdfghjkl", "yxcvbnm"})
+	AZERTYLinearGraph = buildKeyboardGraph([]string{"1234567890", "azertyuiop", "qsdfghjklm", "wxcvbn"})
+	DvorakLinearGraph = buildKeyboardGraph([]string{"1234567890", "pyfgcrl", "aoeuidhtns", "qjkxbmwvz"})
+	// JCUKENLinearGraph is the standard Russian (ЙЦУКЕН) keyboard layout, so
+	// passwords built from Cyrillic keyboard runs (e.g. "йцукен", "фывапр")
+	// are caught alongside the Latin layouts above.
+	JCUKENLinearGraph = buildKeyboardGraph([]string{"1234567890", "йцукенгшщзхъ", "фывапролджэ", "ячсмитьбю"})
+)
+
+// LinearPatternGraphs is the named set of graphs DetectLinearPatterns walks,
+// keyed by the pattern name reported via ValidationError.AddError
+// ("linear_alpha", "linear_qwerty", ...). Register additional layouts by
+// adding to this map; entries not in linearPatternOrder are walked after the
+// built-ins, in name order.
+var LinearPatternGraphs = map[string]LinearPatternGraph{
+	"linear_alpha":  AlphaLinearGraph,
+	"linear_digits": DigitLinearGraph,
+	"linear_qwerty": QWERTYLinearGraph,
+	"linear_qwertz": QWERTZLinearGraph,
+	"linear_azerty": AZERTYLinearGraph,
+	"linear_dvorak": DvorakLinearGraph,
+	"linear_jcuken": JCUKENLinearGraph,
+}
+
+// linearPatternOrder fixes the iteration order over LinearPatternGraphs so
+// DetectLinearPatterns reports errors deterministically.
+var linearPatternOrder = []string{
+	"linear_alpha", "linear_digits", "linear_qwerty", "linear_qwertz", "linear_azerty", "linear_dvorak", "linear_jcuken",
+}
+
+func addLinearEdge(graph LinearPatternGraph, a, b rune) {
+	graph[a] = append(graph[a], b)
+}
+
+// buildSequentialGraph links each rune in seq to its neighbors in both
+// directions, e.g. for "abc": a<->b, b<->c. When includeCase is set, the
+// same edges are duplicated for the uppercase runes, since the alphabetic
+// graph is defined over both cases.
+func buildSequentialGraph(seq string, includeCase bool) LinearPatternGraph {
+	graph := LinearPatternGraph{}
+	runes := []rune(seq)
+
+	for i := 0; i < len(runes)-1; i++ {
+		a, b := runes[i], runes[i+1]
+		addLinearEdge(graph, a, b)
+		addLinearEdge(graph, b, a)
+
+		if includeCase {
+			ua, ub := unicode.ToUpper(a), unicode.ToUpper(b)
+			addLinearEdge(graph, ua, ub)
+			addLinearEdge(graph, ub, ua)
+		}
+	}
+
+	return graph
+}
+
+// buildKeyboardGraph links each key to its right neighbor in the same row
+// and to its two diagonal neighbors in the row below, plus the reverse of
+// every edge, so both forward and backward runs (e.g. "qwerty", "ytrewq")
+// are detected.
+func buildKeyboardGraph(rows []string) LinearPatternGraph {
+	graph := LinearPatternGraph{}
+	rowRunes := make([][]rune, len(rows))
+	for i, row := range rows {
+		rowRunes[i] = []rune(row)
+	}
+
+	for r, row := range rowRunes {
+		for c, ch := range row {
+			if c+1 < len(row) {
+				addLinearEdge(graph, ch, row[c+1])
+				addLinearEdge(graph, row[c+1], ch)
+			}
+
+			if r+1 < len(rowRunes) {
+				below := rowRunes[r+1]
+				for _, dc := range [2]int{0, 1} {
+					nc := c + dc
+					if nc >= 0 && nc < len(below) {
+						addLinearEdge(graph, ch, below[nc])
+						addLinearEdge(graph, below[nc], ch)
+					}
+				}
+			}
+		}
+	}
+
+	return graph
+}
+
+// findLinearRuns walks val once, case-folded, and returns every
+// non-overlapping substring of length >= minRun whose consecutive
+// characters are all linked by graph.
+func findLinearRuns(val string, graph LinearPatternGraph, minRun int) []string {
+	runes := []rune(toLowerRunes(val))
+	var runs []string
+
+	i := 0
+	for i < len(runes) {
+		j := i
+		for j+1 < len(runes) && slices.Contains(graph[runes[j]], runes[j+1]) {
+			j++
+		}
+
+		if j-i+1 >= minRun {
+			runs = append(runs, string(runes[i:j+1]))
+		}
+
+		if j == i {
+			i++
+		} else {
+			i = j + 1
+		}
+	}
+
+	return runs
+}
+
+func toLowerRunes(val string) string {
+	runes := []rune(val)
+	for i, r := range runes {
+		runes[i] = unicode.ToLower(r)
+	}
+	return string(runes)
+}
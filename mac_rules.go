@@ -11,15 +11,16 @@ import (
 type MACRuleName = string
 
 const (
-	MACRuleNameFormat     MACRuleName = "formats"
+	MACRuleNameFormat     MACRuleName = "mac_formats"
 	MACRuleNameMaxOctets  MACRuleName = "max_octets"
 	MACRuleNameCase       MACRuleName = "cases"
 	MACRuleNameType       MACRuleName = "types"
 	MACRuleNameOUI        MACRuleName = "oui_whitelist"
-	MACRuleNameBlacklist  MACRuleName = "blacklist"
+	MACRuleNameVendor     MACRuleName = "vendor_whitelist"
+	MACRuleNameBlacklist  MACRuleName = "mac_blacklist"
 	MACRuleNameAllowZero  MACRuleName = "allow_zero"
 	MACRuleNameAllowBroad MACRuleName = "allow_broadcast"
-	MACRuleNameAllowMulti MACRuleName = "allow_multicast"
+	MACRuleNameAllowMulti MACRuleName = "mac_allow_multicast"
 )
 
 type MACFormat = string
@@ -52,15 +53,20 @@ const (
 
 type MACRules struct {
 	BaseRules
-	Formats        []MACFormat      `json:"formats,omitempty" yaml:"formats"`                 // check MACFormat for available values
-	Cases          []MACCase        `json:"cases,omitempty" yaml:"cases"`                     // check MACCase for available values
-	Types          []MACAddressType `json:"types,omitempty" yaml:"types"`                     // check MACAddressType for available values
-	AllowZero      *bool            `json:"allow_zero,omitempty" yaml:"allow_zero"`           // does 00:00:00:00:00:00 allowed
-	AllowBroadcast *bool            `json:"allow_broadcast,omitempty" yaml:"allow_broadcast"` // does FF:FF:FF:FF:FF:FF allowed
-	AllowMulticast *bool            `json:"allow_multicast,omitempty" yaml:"allow_multicast"` // does 01:00:... allowed
+	Formats        []MACFormat      `json:"mac_formats,omitempty" yaml:"mac_formats"`                 // check MACFormat for available values
+	Cases          []MACCase        `json:"cases,omitempty" yaml:"cases"`                             // check MACCase for available values
+	Types          []MACAddressType `json:"types,omitempty" yaml:"types"`                             // check MACAddressType for available values
+	AllowZero      *bool            `json:"allow_zero,omitempty" yaml:"allow_zero"`                   // does 00:00:00:00:00:00 allowed
+	AllowBroadcast *bool            `json:"allow_broadcast,omitempty" yaml:"allow_broadcast"`         // does FF:FF:FF:FF:FF:FF allowed
+	AllowMulticast *bool            `json:"mac_allow_multicast,omitempty" yaml:"mac_allow_multicast"` // does 01:00:... allowed
 	OUIWhitelist   []string         `json:"oui_whitelist,omitempty" yaml:"oui_whitelist"`
-	Blacklist      []string         `json:"blacklist,omitempty" yaml:"blacklist"`
+	Blacklist      []string         `json:"mac_blacklist,omitempty" yaml:"mac_blacklist"`
 	MaxOctets      *int             `json:"max_octets,omitempty" yaml:"max_octets"`
+	// VendorWhitelist names vendors instead of raw hex prefixes: each entry
+	// is matched case-insensitively as a substring against ouiTable's
+	// registered organization names, and the MAC is accepted if its
+	// assignment prefix resolves (via LookupVendor) to any matching vendor.
+	VendorWhitelist []string `json:"vendor_whitelist,omitempty" yaml:"vendor_whitelist"`
 }
 
 func (r *MACRules) Validate(i any) error {
@@ -68,7 +74,7 @@ func (r *MACRules) Validate(i any) error {
 
 	if i == nil {
 		if r.Required {
-			err.AddError(BaseRuleNameRequired, r.Required, i, FieldIsRequired)
+			err.AddReasonError(BaseRuleNameRequired, ReasonRequired, r.Required, i, FieldIsRequired)
 			return err
 		}
 		return nil
@@ -78,7 +84,7 @@ func (r *MACRules) Validate(i any) error {
 	case *string:
 		if v == nil {
 			if r.Required {
-				err.AddError(BaseRuleNameRequired, r.Required, i, FieldIsRequired)
+				err.AddReasonError(BaseRuleNameRequired, ReasonRequired, r.Required, i, FieldIsRequired)
 				return err
 			}
 			return nil
@@ -87,7 +93,7 @@ func (r *MACRules) Validate(i any) error {
 	case string:
 		if v == "" {
 			if r.Required {
-				err.AddError(BaseRuleNameRequired, r.Required, i, FieldIsRequired)
+				err.AddReasonError(BaseRuleNameRequired, ReasonRequired, r.Required, i, FieldIsRequired)
 				return err
 			}
 			return nil
@@ -95,7 +101,7 @@ func (r *MACRules) Validate(i any) error {
 	case net.HardwareAddr:
 		if v == nil {
 			if r.Required {
-				err.AddError(BaseRuleNameRequired, r.Required, i, FieldIsRequired)
+				err.AddReasonError(BaseRuleNameRequired, ReasonRequired, r.Required, i, FieldIsRequired)
 				return err
 			}
 			return nil
@@ -104,58 +110,58 @@ func (r *MACRules) Validate(i any) error {
 	case *net.HardwareAddr:
 		if v == nil {
 			if r.Required {
-				err.AddError(BaseRuleNameRequired, r.Required, i, FieldIsRequired)
+				err.AddReasonError(BaseRuleNameRequired, ReasonRequired, r.Required, i, FieldIsRequired)
 				return err
 			}
 			return nil
 		}
 		if *v == nil {
 			if r.Required {
-				err.AddError(BaseRuleNameRequired, r.Required, i, FieldIsRequired)
+				err.AddReasonError(BaseRuleNameRequired, ReasonRequired, r.Required, i, FieldIsRequired)
 				return err
 			}
 			return nil
 		}
 		i = (*v).String()
 	default:
-		err.AddError(BaseRuleNameType, TypeIP, i, "value must be a string or net.HardwareAddr or ptr of them")
+		err.AddReasonError(BaseRuleNameType, ReasonType, TypeIP, i, "value must be a string or net.HardwareAddr or ptr of them")
 		return err
 	}
 
 	val, ok := i.(string)
 	if !ok {
-		err.AddError(BaseRuleNameType, TypeMAC, i, "value must be a string")
+		err.AddReasonError(BaseRuleNameType, ReasonType, TypeMAC, i, "value must be a string")
 		return err
 	}
 
 	normalized := r.normalizeMAC(val)
 	if normalized == "" {
 		if len(r.Formats) > 0 {
-			err.AddError(MACRuleNameFormat, r.Formats, i, "invalid MAC address format")
+			err.AddReasonError(MACRuleNameFormat, ReasonMACFormat, r.Formats, i, "invalid MAC address format")
 			return err
 		}
-		err.AddError(MACRuleNameFormat, MACFormatAny, i, "invalid MAC address format")
+		err.AddReasonError(MACRuleNameFormat, ReasonMACFormat, MACFormatAny, i, "invalid MAC address format")
 		return err
 	}
 
 	if r.MaxOctets != nil {
 		octets := len(normalized) / 2
 		if octets > *r.MaxOctets {
-			err.AddError(MACRuleNameMaxOctets, r.MaxOctets, i, "too many octets in MAC address")
+			err.AddReasonError(MACRuleNameMaxOctets, ReasonMACMaxOctets, r.MaxOctets, i, "too many octets in MAC address")
 			return err
 		}
 	}
 
 	if len(r.Formats) > 0 {
 		if !r.validateFormat(val) {
-			err.AddError(MACRuleNameFormat, r.Formats, i, "invalid MAC address format")
+			err.AddReasonError(MACRuleNameFormat, ReasonMACFormat, r.Formats, i, "invalid MAC address format")
 			return err
 		}
 	}
 
 	if len(r.Cases) > 0 {
 		if !r.validateCase(val) {
-			err.AddError(MACRuleNameCase, r.Cases, i, "incorrect MAC address case")
+			err.AddReasonError(MACRuleNameCase, ReasonMACCase, r.Cases, i, "incorrect MAC address case")
 			return err
 		}
 	}
@@ -169,7 +175,7 @@ func (r *MACRules) Validate(i any) error {
 			}
 		}
 		if !valid {
-			err.AddError(MACRuleNameType, r.Types, i, "MAC address does not match any of the required types")
+			err.AddReasonError(MACRuleNameType, ReasonMACType, r.Types, i, "MAC address does not match any of the required types")
 			return err
 		}
 	}
@@ -184,7 +190,15 @@ func (r *MACRules) Validate(i any) error {
 			}
 		}
 		if !valid {
-			err.AddError(MACRuleNameOUI, r.OUIWhitelist, i, "MAC address OUI not in allowed list")
+			err.AddReasonError(MACRuleNameOUI, ReasonMACOUI, r.OUIWhitelist, i, "MAC address OUI not in allowed list")
+			return err
+		}
+	}
+
+	if len(r.VendorWhitelist) > 0 {
+		vendor, found := lookupVendorByPrefix(normalized)
+		if !found || !vendorMatchesAny(vendor, r.VendorWhitelist) {
+			err.AddReasonError(MACRuleNameVendor, ReasonMACVendor, r.VendorWhitelist, i, "MAC address vendor not in allowed list")
 			return err
 		}
 	}
@@ -192,25 +206,25 @@ func (r *MACRules) Validate(i any) error {
 	if len(r.Blacklist) > 0 {
 		for _, blocked := range r.Blacklist {
 			if strings.HasPrefix(strings.ToLower(normalized), strings.ToLower(blocked)) {
-				err.AddError(MACRuleNameBlacklist, r.Blacklist, i, "MAC address is blacklisted")
+				err.AddReasonError(MACRuleNameBlacklist, ReasonMACBlacklist, r.Blacklist, i, "MAC address is blacklisted")
 				return err
 			}
 		}
 	}
 
 	if isZeroMAC(normalized) && !(r.AllowZero != nil && *r.AllowZero) {
-		err.AddError(MACRuleNameAllowZero, false, i, "zero MAC address is not allowed")
+		err.AddReasonError(MACRuleNameAllowZero, ReasonMACZero, false, i, "zero MAC address is not allowed")
 		return err
 	}
 	if isBroadcastMAC(normalized) {
 		if !(r.AllowBroadcast != nil && *r.AllowBroadcast) {
-			err.AddError(MACRuleNameAllowBroad, false, i, "broadcast MAC address is not allowed")
+			err.AddReasonError(MACRuleNameAllowBroad, ReasonMACBroadcast, false, i, "broadcast MAC address is not allowed")
 			return err
 		}
 		return nil // If broadcast is allowed, we don't need to check multicast
 	}
 	if isMulticastMAC(normalized) && !(r.AllowMulticast != nil && *r.AllowMulticast) {
-		err.AddError(MACRuleNameAllowMulti, false, i, "multicast MAC address is not allowed")
+		err.AddReasonError(MACRuleNameAllowMulti, ReasonMACMulticast, false, i, "multicast MAC address is not allowed")
 		return err
 	}
 
@@ -305,6 +319,45 @@ func (r *MACRules) validateType(mac string, typ MACAddressType) bool {
 	}
 }
 
+// LookupVendor returns the IEEE-registered vendor name for mac's assignment
+// prefix, or false if mac doesn't parse or no entry in ouiTable matches.
+func LookupVendor(mac string) (string, bool) {
+	normalized := (&MACRules{}).normalizeMAC(mac)
+	if normalized == "" {
+		return "", false
+	}
+	return lookupVendorByPrefix(normalized)
+}
+
+// lookupVendorByPrefix tries the longest registered prefix length first
+// (MA-S, 9 hex digits; then MA-M, 7; then MA-L, 6), since an MA-M/MA-S
+// assignment is a sub-block carved out of a /24 some other organization
+// holds - matching 6 hex digits first would attribute it to the wrong
+// vendor whenever both a 6- and a longer-digit entry share that /24.
+func lookupVendorByPrefix(normalized string) (string, bool) {
+	for _, length := range []int{9, 7, 6} {
+		if len(normalized) < length {
+			continue
+		}
+		if vendor, ok := ouiTable[normalized[:length]]; ok {
+			return vendor, true
+		}
+	}
+	return "", false
+}
+
+// vendorMatchesAny reports whether vendor contains any of whitelist's
+// entries as a case-insensitive substring.
+func vendorMatchesAny(vendor string, whitelist []string) bool {
+	lower := strings.ToLower(vendor)
+	for _, want := range whitelist {
+		if strings.Contains(lower, strings.ToLower(want)) {
+			return true
+		}
+	}
+	return false
+}
+
 func isZeroMAC(mac string) bool {
 	return strings.ToLower(mac) == strings.Repeat("0", len(mac))
 }
@@ -241,6 +241,40 @@ func TestMACRules(t *testing.T) {
 			wantErr: false,
 		},
 
+		// Vendor whitelist validation tests
+		{
+			name: "valid vendor, exact organization substring",
+			rules: MACRules{
+				VendorWhitelist: []string{"Cisco"},
+			},
+			value:   "00:00:0c:33:44:55",
+			wantErr: false,
+		},
+		{
+			name: "valid vendor, case-insensitive match",
+			rules: MACRules{
+				VendorWhitelist: []string{"apple"},
+			},
+			value:   "00:1b:63:33:44:55",
+			wantErr: false,
+		},
+		{
+			name: "vendor not in allowed list",
+			rules: MACRules{
+				VendorWhitelist: []string{"Cisco"},
+			},
+			value:   "00:1b:63:33:44:55",
+			wantErr: true,
+		},
+		{
+			name: "vendor unknown OUI",
+			rules: MACRules{
+				VendorWhitelist: []string{"Cisco"},
+			},
+			value:   "de:ad:be:33:44:55",
+			wantErr: true,
+		},
+
 		// Blacklist validation tests
 		{
 			name: "blacklisted MAC",
@@ -385,3 +419,33 @@ func TestMACRules(t *testing.T) {
 		})
 	}
 }
+
+func TestLookupVendor(t *testing.T) {
+	vendor, ok := LookupVendor("00:00:0c:33:44:55")
+	assert.True(t, ok)
+	assert.Equal(t, "Cisco Systems, Inc", vendor)
+
+	vendor, ok = LookupVendor("00-21-9b-60-00-01") // MA-M prefix, 7 hex digits
+	assert.True(t, ok)
+	assert.Equal(t, "Example MA-M Vendor A", vendor)
+
+	vendor, ok = LookupVendor("70-3a-0e-12-30-01") // MA-S prefix, 9 hex digits
+	assert.True(t, ok)
+	assert.Equal(t, "Example MA-S Vendor A", vendor)
+
+	_, ok = LookupVendor("de:ad:be:33:44:55")
+	assert.False(t, ok)
+
+	_, ok = LookupVendor("not-a-mac")
+	assert.False(t, ok)
+}
+
+func TestMACRules_ReasonCodes(t *testing.T) {
+	rules := MACRules{Formats: []MACFormat{MACFormatColon}}
+	err := rules.Validate("not-a-mac")
+	assert.Error(t, err)
+
+	ve, ok := err.(*ValidationError)
+	assert.True(t, ok)
+	assert.Equal(t, ReasonMACFormat, ve.First().Reason)
+}
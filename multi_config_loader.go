@@ -0,0 +1,279 @@
+package sval
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+)
+
+// MultiConfigLoader layers several ConfigLoaders together, merging their
+// ValidatorConfigs in the order given so each one overrides the fields (and
+// sub-rules) of the ones before it - e.g. a checked-in file providing
+// defaults, followed by an EnvConfigLoader tightening or overriding a
+// handful of rules per deployment. See DefaultConfigLoader for the loader
+// every plain New() call now goes through.
+type MultiConfigLoader struct {
+	Loaders []ConfigLoader
+}
+
+// NewMultiConfigLoader layers loaders in the given order; later loaders
+// override earlier ones field-by-field.
+func NewMultiConfigLoader(loaders ...ConfigLoader) *MultiConfigLoader {
+	return &MultiConfigLoader{Loaders: loaders}
+}
+
+func (l *MultiConfigLoader) Load() (ValidatorConfig, error) {
+	var merged ValidatorConfig
+	for i, loader := range l.Loaders {
+		cfg, err := loader.Load()
+		if err != nil {
+			return ValidatorConfig{}, fmt.Errorf("config source %d: %w", i, err)
+		}
+		merged = mergeValidatorConfig(merged, cfg)
+	}
+	return merged, nil
+}
+
+// mergeValidatorConfig overlays overlay's fields onto base, field-by-field
+// and rule-by-rule, so a loader only needs to contribute the rules it cares
+// about rather than a complete config.
+func mergeValidatorConfig(base, overlay ValidatorConfig) ValidatorConfig {
+	if overlay.Version != 0 {
+		base.Version = overlay.Version
+	}
+
+	if len(overlay.Rules) > 0 {
+		if base.Rules == nil {
+			base.Rules = make(map[string]RuleConfig, len(overlay.Rules))
+		}
+		for field, cfg := range overlay.Rules {
+			base.Rules[field] = cfg
+		}
+	}
+
+	if len(overlay.SubRules) > 0 {
+		if base.SubRules == nil {
+			base.SubRules = make(map[string]RuleConfig, len(overlay.SubRules))
+		}
+		for name, cfg := range overlay.SubRules {
+			base.SubRules[name] = cfg
+		}
+	}
+
+	return base
+}
+
+// defaultEnvRulePrefix is the env var prefix EnvConfigLoader looks for when
+// Prefix isn't set.
+const defaultEnvRulePrefix = "SVAL_RULES_"
+
+// EnvConfigLoader builds a ValidatorConfig from JSON-encoded RuleConfig
+// snippets in environment variables named <Prefix><FIELD>, e.g.
+//
+//	SVAL_RULES_EMAIL='{"type":"email","params":{"strategy":"rfc5322"}}'
+//
+// sets the rule for field "email" (the part of the var name after Prefix,
+// lowercased). It's meant to be layered after a file-based loader via
+// MultiConfigLoader - see DefaultConfigLoader - so a single rule can be
+// overridden per environment without touching the checked-in sval.yaml.
+type EnvConfigLoader struct {
+	// Prefix defaults to defaultEnvRulePrefix when empty.
+	Prefix string
+}
+
+func (l *EnvConfigLoader) prefix() string {
+	if l.Prefix != "" {
+		return l.Prefix
+	}
+	return defaultEnvRulePrefix
+}
+
+func (l *EnvConfigLoader) Load() (ValidatorConfig, error) {
+	prefix := l.prefix()
+	config := ValidatorConfig{Rules: make(map[string]RuleConfig)}
+
+	for _, kv := range os.Environ() {
+		key, value, ok := strings.Cut(kv, "=")
+		if !ok || !strings.HasPrefix(key, prefix) {
+			continue
+		}
+
+		field := strings.ToLower(strings.TrimPrefix(key, prefix))
+		if field == "" {
+			continue
+		}
+
+		var ruleCfg RuleConfig
+		if err := json.Unmarshal([]byte(value), &ruleCfg); err != nil {
+			return ValidatorConfig{}, fmt.Errorf("env var %s: %w", key, err)
+		}
+		config.Rules[field] = expandEnvInRuleConfig(ruleCfg)
+	}
+
+	return config, nil
+}
+
+// HTTPConfigLoader pulls a ValidatorConfig as JSON from a central config
+// service. The ETag it last saw is sent as If-None-Match on every
+// subsequent request, so a server that replies 304 Not Modified gets
+// Load() returning the last successfully decoded config instead of
+// re-parsing an empty body. mu guards etag/cached so Load is safe to call
+// from multiple goroutines sharing one loader (e.g. a periodic refresher
+// alongside an on-demand reload).
+type HTTPConfigLoader struct {
+	URL     string
+	Headers map[string]string
+	// ETag seeds the initial If-None-Match value; leave empty to always
+	// fetch on the first call. Read/written under mu once Load has run.
+	ETag   string
+	Client *http.Client
+
+	mu     sync.Mutex
+	cached ValidatorConfig
+}
+
+func (l *HTTPConfigLoader) client() *http.Client {
+	if l.Client != nil {
+		return l.Client
+	}
+	return http.DefaultClient
+}
+
+func (l *HTTPConfigLoader) Load() (ValidatorConfig, error) {
+	l.mu.Lock()
+	etag := l.ETag
+	l.mu.Unlock()
+
+	req, err := http.NewRequest(http.MethodGet, l.URL, nil)
+	if err != nil {
+		return ValidatorConfig{}, err
+	}
+	for k, v := range l.Headers {
+		req.Header.Set(k, v)
+	}
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+
+	resp, err := l.client().Do(req)
+	if err != nil {
+		return ValidatorConfig{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		return l.cached, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return ValidatorConfig{}, fmt.Errorf("sval: config server returned %s", resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return ValidatorConfig{}, err
+	}
+
+	var config ValidatorConfig
+	if err := json.Unmarshal(body, &config); err != nil {
+		return ValidatorConfig{}, err
+	}
+	config = expandEnvInConfig(config)
+
+	l.mu.Lock()
+	if newEtag := resp.Header.Get("ETag"); newEtag != "" {
+		l.ETag = newEtag
+	}
+	l.cached = config
+	l.mu.Unlock()
+
+	return config, nil
+}
+
+// EmbeddedConfigLoader reads a ValidatorConfig from an fs.FS - typically a
+// //go:embed directive - so the config ships inside the binary instead of
+// depending on a file being present on disk at runtime.
+type EmbeddedConfigLoader struct {
+	FS   fs.FS
+	Path string
+}
+
+func (l *EmbeddedConfigLoader) Load() (ValidatorConfig, error) {
+	data, err := fs.ReadFile(l.FS, l.Path)
+	if err != nil {
+		return ValidatorConfig{}, err
+	}
+
+	return decodeValidatorConfig(l.Path, data)
+}
+
+// expandEnvInConfig expands ${ENV_VAR} references inside every rule's
+// string params, across both Rules and SubRules, so a blacklist or regex
+// pattern can be parameterized per environment without the config itself
+// changing. Called once per Load, right after decoding.
+func expandEnvInConfig(cfg ValidatorConfig) ValidatorConfig {
+	for field, ruleCfg := range cfg.Rules {
+		cfg.Rules[field] = expandEnvInRuleConfig(ruleCfg)
+	}
+	for name, ruleCfg := range cfg.SubRules {
+		cfg.SubRules[name] = expandEnvInRuleConfig(ruleCfg)
+	}
+	return cfg
+}
+
+// expandEnvInRuleConfig recurses into AnyOf/AllOf/OneOf/Not so a combinator
+// nested several levels deep still gets its Params expanded.
+func expandEnvInRuleConfig(cfg RuleConfig) RuleConfig {
+	cfg.Params = expandEnvInParams(cfg.Params)
+
+	for i := range cfg.AnyOf {
+		cfg.AnyOf[i] = expandEnvInRuleConfig(cfg.AnyOf[i])
+	}
+	for i := range cfg.AllOf {
+		cfg.AllOf[i] = expandEnvInRuleConfig(cfg.AllOf[i])
+	}
+	for i := range cfg.OneOf {
+		cfg.OneOf[i] = expandEnvInRuleConfig(cfg.OneOf[i])
+	}
+	if cfg.Not != nil {
+		expanded := expandEnvInRuleConfig(*cfg.Not)
+		cfg.Not = &expanded
+	}
+
+	return cfg
+}
+
+func expandEnvInParams(params map[string]any) map[string]any {
+	if params == nil {
+		return nil
+	}
+
+	expanded := make(map[string]any, len(params))
+	for k, v := range params {
+		expanded[k] = expandEnvInValue(v)
+	}
+	return expanded
+}
+
+func expandEnvInValue(v any) any {
+	switch val := v.(type) {
+	case string:
+		return os.Expand(val, os.Getenv)
+	case []any:
+		out := make([]any, len(val))
+		for i, item := range val {
+			out[i] = expandEnvInValue(item)
+		}
+		return out
+	case map[string]any:
+		return expandEnvInParams(val)
+	default:
+		return v
+	}
+}
@@ -0,0 +1,183 @@
+package sval
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultiConfigLoader_LaterOverridesEarlier(t *testing.T) {
+	base := staticConfigLoader{cfg: ValidatorConfig{
+		Rules: map[string]RuleConfig{
+			"name":  {Type: "string", Params: map[string]any{"min_len": 1}},
+			"email": {Type: "email"},
+		},
+	}}
+	override := staticConfigLoader{cfg: ValidatorConfig{
+		Rules: map[string]RuleConfig{
+			"name": {Type: "string", Params: map[string]any{"min_len": 5}},
+		},
+	}}
+
+	merged, err := NewMultiConfigLoader(base, override).Load()
+	assert.NoError(t, err)
+	assert.Equal(t, 5, merged.Rules["name"].Params["min_len"])
+	assert.Equal(t, "email", merged.Rules["email"].Type)
+}
+
+func TestMultiConfigLoader_PropagatesSourceError(t *testing.T) {
+	_, err := NewMultiConfigLoader(
+		staticConfigLoader{cfg: ValidatorConfig{}},
+		&FileConfigLoader{Path: "does-not-exist.yaml"},
+	).Load()
+	assert.Error(t, err)
+}
+
+type staticConfigLoader struct {
+	cfg ValidatorConfig
+}
+
+func (l staticConfigLoader) Load() (ValidatorConfig, error) {
+	return l.cfg, nil
+}
+
+func TestEnvConfigLoader_ReadsPrefixedVars(t *testing.T) {
+	t.Setenv("SVAL_RULES_EMAIL", `{"type":"email","params":{"strategy":"rfc5322"}}`)
+	t.Setenv("SVAL_RULES_NAME", `{"type":"string","params":{"min_len":2}}`)
+	t.Setenv("UNRELATED_VAR", "ignored")
+
+	cfg, err := (&EnvConfigLoader{}).Load()
+	assert.NoError(t, err)
+	assert.Equal(t, "email", cfg.Rules["email"].Type)
+	assert.Equal(t, "string", cfg.Rules["name"].Type)
+	_, hasUnrelated := cfg.Rules["unrelated_var"]
+	assert.False(t, hasUnrelated)
+}
+
+func TestEnvConfigLoader_InvalidJSON(t *testing.T) {
+	t.Setenv("SVAL_RULES_BROKEN", `not json`)
+
+	_, err := (&EnvConfigLoader{}).Load()
+	assert.Error(t, err)
+}
+
+func TestEmbeddedConfigLoader_ReadsFromFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"sval.json": &fstest.MapFile{Data: []byte(`{
+			"rules": {
+				"name": {"type": "string", "params": {"min_len": 2}}
+			}
+		}`)},
+	}
+
+	cfg, err := (&EmbeddedConfigLoader{FS: fsys, Path: "sval.json"}).Load()
+	assert.NoError(t, err)
+	assert.Equal(t, "string", cfg.Rules["name"].Type)
+}
+
+func TestHTTPConfigLoader_LoadsAndCachesViaETag(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == "v1" {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", "v1")
+		_ = json.NewEncoder(w).Encode(ValidatorConfig{
+			Rules: map[string]RuleConfig{"name": {Type: "string"}},
+		})
+	}))
+	defer srv.Close()
+
+	loader := &HTTPConfigLoader{URL: srv.URL}
+
+	cfg, err := loader.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, "string", cfg.Rules["name"].Type)
+	assert.Equal(t, "v1", loader.ETag)
+
+	cfg, err = loader.Load()
+	assert.NoError(t, err)
+	assert.Equal(t, "string", cfg.Rules["name"].Type)
+	assert.Equal(t, 2, requests)
+}
+
+func TestExpandEnvInConfig_ExpandsStringParams(t *testing.T) {
+	t.Setenv("SVAL_TEST_DOMAIN", "acme.org")
+
+	cfg := ValidatorConfig{
+		Rules: map[string]RuleConfig{
+			"email": {
+				AllOf: []RuleConfig{
+					{Type: "email", Params: map[string]any{
+						"allowed_domains": []any{"${SVAL_TEST_DOMAIN}"},
+					}},
+				},
+			},
+		},
+	}
+
+	expanded := expandEnvInConfig(cfg)
+	domains := expanded.Rules["email"].AllOf[0].Params["allowed_domains"].([]any)
+	assert.Equal(t, "acme.org", domains[0])
+}
+
+func TestHTTPConfigLoader_ConcurrentLoadIsRaceFree(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", "v1")
+		_ = json.NewEncoder(w).Encode(ValidatorConfig{
+			Rules: map[string]RuleConfig{"name": {Type: "string"}},
+		})
+	}))
+	defer srv.Close()
+
+	loader := &HTTPConfigLoader{URL: srv.URL}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := loader.Load()
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestNew_ErrorsWithNoFileAndNoEnvRules(t *testing.T) {
+	dir := t.TempDir()
+	cwd, err := os.Getwd()
+	assert.NoError(t, err)
+	assert.NoError(t, os.Chdir(dir))
+	defer func() { assert.NoError(t, os.Chdir(cwd)) }()
+
+	_, err = New()
+	assert.Error(t, err)
+}
+
+func TestDefaultConfigLoader_ComposesFileAndEnv(t *testing.T) {
+	path := "sval.yaml"
+	assert.NoError(t, os.WriteFile(path, []byte(`
+rules:
+  name:
+    type: string
+    params:
+      min_len: 1
+`), 0644))
+	defer os.Remove(path)
+
+	t.Setenv("SVAL_RULES_NAME", `{"type":"string","params":{"min_len":9}}`)
+
+	cfg, err := DefaultConfigLoader().Load()
+	assert.NoError(t, err)
+	// The env var, loaded after the file, wins.
+	assert.Equal(t, float64(9), cfg.Rules["name"].Params["min_len"])
+}
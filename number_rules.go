@@ -1,54 +1,113 @@
 package sval
 
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
 type NumberRuleName = string
 
 const (
-	NumberRuleNameMin NumberRuleName = "min"
-	NumberRuleNameMax NumberRuleName = "max"
+	NumberRuleNameMin           NumberRuleName = "min"
+	NumberRuleNameMax           NumberRuleName = "max"
+	NumberRuleNameExclusiveMin  NumberRuleName = "exclusive_min"
+	NumberRuleNameExclusiveMax  NumberRuleName = "exclusive_max"
+	NumberRuleNameMultipleOf    NumberRuleName = "multiple_of"
+	NumberRuleNameAllowedValues NumberRuleName = "allowed_values"
+	NumberRuleNameNonZero       NumberRuleName = "non_zero"
 )
 
-type NumberRules struct {
+// Number is the set of types NumberRules can validate directly.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// NumberRules validates a numeric value of type T. It coerces
+// loosely-typed input before comparing: other
+// int/uint widths, float64, json.Number (the shape encoding/json produces
+// when a struct tag decodes into any), pointers to any of those, and
+// strings that parse cleanly. IntRules and FloatRules are thin
+// instantiations of this type kept for backward compatibility.
+type NumberRules[T Number] struct {
 	BaseRules
-	Min *float64 `json:"min" yaml:"min"`
-	Max *float64 `json:"max" yaml:"max"`
+	Min          *T `json:"min" yaml:"min"`
+	Max          *T `json:"max" yaml:"max"`
+	ExclusiveMin *T `json:"exclusive_min" yaml:"exclusive_min"`
+	ExclusiveMax *T `json:"exclusive_max" yaml:"exclusive_max"`
+	MultipleOf   *T `json:"multiple_of" yaml:"multiple_of"`
+	// AllowedValues, when non-empty, restricts the value to this whitelist.
+	AllowedValues []T `json:"allowed_values" yaml:"allowed_values"`
+	// AllowNaN/AllowInf let a float T's NaN/+-Inf values through instead of
+	// being rejected as a type error. Meaningless for integer T.
+	AllowNaN bool `json:"allow_nan" yaml:"allow_nan"`
+	AllowInf bool `json:"allow_inf" yaml:"allow_inf"`
+	// NonZero rejects T's zero value once it's been required and coerced,
+	// without conflating it with Required: Required only rejects a missing
+	// (nil) value, so 0 still passes Required on its own, exactly as a
+	// legitimate "amount: 0" should. Set NonZero when zero specifically
+	// isn't a valid value for this field.
+	NonZero bool `json:"non_zero" yaml:"non_zero"`
 }
 
-func (r *NumberRules) Validate(i any) error {
+func (r *NumberRules[T]) Validate(i any) error {
 	err := NewValidationError()
 
-	if i == nil {
+	if i == nil || isNilPointer(i) {
 		if r.Required {
-			err.AddError(BaseRuleNameRequired, r.Required, FieldIsRequired)
-		}
-		return err
-	}
-
-	if ptr, ok := i.(*int); ok {
-		if ptr == nil {
-			if r.Required {
-				err.AddError(BaseRuleNameRequired, r.Required, FieldIsRequired)
-			}
+			err.AddReasonError(BaseRuleNameRequired, ReasonRequired, r.Required, i, FieldIsRequired)
 			return err
 		}
-		i = *ptr
+		return nil
 	}
 
-	val, ok := i.(int)
+	val, ok := coerceNumber[T](i)
 	if !ok {
-		err.AddError(BaseRuleNameType, "number", "value must be a number")
+		err.AddReasonError(BaseRuleNameType, ReasonType, numberRuleType[T](), i, "value must be a number")
 		return err
 	}
 
-	if r.Required && val == 0 {
-		err.AddError(BaseRuleNameRequired, r.Required, FieldIsRequired)
+	if f := float64(val); math.IsNaN(f) && !r.AllowNaN {
+		err.AddReasonError(BaseRuleNameType, ReasonNaN, numberRuleType[T](), i, "value must not be NaN")
+		return err
+	} else if math.IsInf(f, 0) && !r.AllowInf {
+		err.AddReasonError(BaseRuleNameType, ReasonInf, numberRuleType[T](), i, "value must not be infinite")
+		return err
 	}
 
-	if r.Min != nil && float64(val) < *r.Min {
-		err.AddError(NumberRuleNameMin, *r.Min, "value must be greater than or equal to min")
+	if r.Min != nil && val < *r.Min {
+		err.AddReasonError(NumberRuleNameMin, ReasonMin, *r.Min, i, "value must be greater than or equal to min")
 	}
 
-	if r.Max != nil && float64(val) > *r.Max {
-		err.AddError(NumberRuleNameMax, *r.Max, "value must be less than or equal to max")
+	if r.Max != nil && val > *r.Max {
+		err.AddReasonError(NumberRuleNameMax, ReasonMax, *r.Max, i, "value must be less than or equal to max")
+	}
+
+	if r.ExclusiveMin != nil && val <= *r.ExclusiveMin {
+		err.AddReasonError(NumberRuleNameExclusiveMin, ReasonExclusiveMin, *r.ExclusiveMin, i, "value must be greater than exclusive_min")
+	}
+
+	if r.ExclusiveMax != nil && val >= *r.ExclusiveMax {
+		err.AddReasonError(NumberRuleNameExclusiveMax, ReasonExclusiveMax, *r.ExclusiveMax, i, "value must be less than exclusive_max")
+	}
+
+	if r.MultipleOf != nil && *r.MultipleOf != 0 && math.Mod(float64(val), float64(*r.MultipleOf)) != 0 {
+		err.AddReasonError(NumberRuleNameMultipleOf, ReasonMultipleOf, *r.MultipleOf, i, "value must be a multiple of multiple_of")
+	}
+
+	if len(r.AllowedValues) > 0 && !numberSliceContains(r.AllowedValues, val) {
+		err.AddReasonError(NumberRuleNameAllowedValues, ReasonAllowedValues, r.AllowedValues, i, "value must be one of the allowed values")
+	}
+
+	if r.NonZero {
+		var zero T
+		if val == zero {
+			err.AddReasonError(NumberRuleNameNonZero, ReasonNonZero, nil, i, "value must not be zero")
+		}
 	}
 
 	if err.HasErrors() {
@@ -57,3 +116,93 @@ func (r *NumberRules) Validate(i any) error {
 
 	return nil
 }
+
+// coerceNumber converts i to T, unwrapping pointers and parsing
+// json.Number/string values. It reports false rather than guessing when i
+// isn't a number at all.
+func coerceNumber[T Number](i any) (T, bool) {
+	if v, ok := i.(T); ok {
+		return v, true
+	}
+
+	switch v := i.(type) {
+	case json.Number:
+		return coerceNumberFromString[T](string(v))
+	case string:
+		return coerceNumberFromString[T](v)
+	case int:
+		return T(v), true
+	case int8:
+		return T(v), true
+	case int16:
+		return T(v), true
+	case int32:
+		return T(v), true
+	case int64:
+		return T(v), true
+	case uint:
+		return T(v), true
+	case uint8:
+		return T(v), true
+	case uint16:
+		return T(v), true
+	case uint32:
+		return T(v), true
+	case uint64:
+		return T(v), true
+	case float32:
+		return T(v), true
+	case float64:
+		return T(v), true
+	}
+
+	rv := reflect.ValueOf(i)
+	if rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			var zero T
+			return zero, false
+		}
+		return coerceNumber[T](rv.Elem().Interface())
+	}
+
+	var zero T
+	return zero, false
+}
+
+// isNilPointer reports whether i holds a typed nil pointer (e.g. a nil
+// *int boxed in an any), which == nil does not catch.
+func isNilPointer(i any) bool {
+	rv := reflect.ValueOf(i)
+	return rv.Kind() == reflect.Ptr && rv.IsNil()
+}
+
+func coerceNumberFromString[T Number](s string) (T, bool) {
+	f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil {
+		var zero T
+		return zero, false
+	}
+	return T(f), true
+}
+
+// numberRuleType reports the BaseRuleNameType value a NumberRules[T] type
+// error should carry, so TypeInt/TypeFloat stay meaningful for IntRules and
+// FloatRules built on top of this engine.
+func numberRuleType[T Number]() RuleType {
+	var zero T
+	switch any(zero).(type) {
+	case float32, float64:
+		return TypeFloat
+	default:
+		return TypeInt
+	}
+}
+
+func numberSliceContains[T Number](vals []T, v T) bool {
+	for _, x := range vals {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,132 @@
+package sval
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNumberRulesCoercion(t *testing.T) {
+	min := 0
+	max := 100
+
+	testCases := []struct {
+		name    string
+		input   any
+		wantErr bool
+	}{
+		{name: "int", input: 42, wantErr: false},
+		{name: "int64", input: int64(42), wantErr: false},
+		{name: "uint", input: uint(42), wantErr: false},
+		{name: "float64", input: float64(42), wantErr: false},
+		{name: "json.Number", input: json.Number("42"), wantErr: false},
+		{name: "numeric string", input: "42", wantErr: false},
+		{name: "pointer to int", input: ptr(42), wantErr: false},
+		{name: "nil pointer", input: (*int)(nil), wantErr: false},
+		{name: "out of range", input: 101, wantErr: true},
+		{name: "unparseable string", input: "not a number", wantErr: true},
+		{name: "non-numeric type", input: []int{1}, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rules := NumberRules[int]{Min: &min, Max: &max}
+			err := rules.Validate(tc.input)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNumberRulesExclusiveBounds(t *testing.T) {
+	exMin := 0.0
+	exMax := 10.0
+	rules := NumberRules[float64]{ExclusiveMin: &exMin, ExclusiveMax: &exMax}
+
+	assert.Error(t, rules.Validate(0.0), "exclusive_min is not satisfied by the boundary value")
+	assert.Error(t, rules.Validate(10.0), "exclusive_max is not satisfied by the boundary value")
+	assert.NoError(t, rules.Validate(5.0))
+}
+
+func TestNumberRulesMultipleOf(t *testing.T) {
+	multipleOf := 5
+	rules := NumberRules[int]{MultipleOf: &multipleOf}
+
+	assert.NoError(t, rules.Validate(15))
+	assert.Error(t, rules.Validate(7))
+}
+
+func TestNumberRulesAllowedValues(t *testing.T) {
+	rules := NumberRules[int]{AllowedValues: []int{1, 2, 3}}
+
+	assert.NoError(t, rules.Validate(2))
+	assert.Error(t, rules.Validate(4))
+}
+
+func TestNumberRulesNaNAndInf(t *testing.T) {
+	nan := NumberRules[float64]{}
+	err := nan.Validate(math.NaN())
+	assert.Error(t, err, "NaN must be rejected when AllowNaN is false")
+
+	allowed := NumberRules[float64]{AllowNaN: true, AllowInf: true}
+	assert.NoError(t, allowed.Validate(math.NaN()))
+	assert.NoError(t, allowed.Validate(math.Inf(1)))
+}
+
+func TestNumberRulesNonZero(t *testing.T) {
+	rules := NumberRules[int]{NonZero: true}
+
+	assert.NoError(t, rules.Validate(5))
+	err := rules.Validate(0)
+	assert.Error(t, err, "zero must be rejected when NonZero is set")
+
+	// Required only rejects a missing value, not a present zero: a zero
+	// value must still pass Required on its own when NonZero isn't set.
+	required := NumberRules[int]{BaseRules: BaseRules{Required: true}}
+	assert.NoError(t, required.Validate(0), "Required must not conflate a present zero with a missing value")
+}
+
+func TestNumberRulesAcrossKinds(t *testing.T) {
+	min := 1.0
+	max := 10.0
+
+	t.Run("int8", func(t *testing.T) {
+		rules := NumberRules[int8]{Min: ptr(int8(1)), Max: ptr(int8(10))}
+		assert.NoError(t, rules.Validate(int8(5)))
+		assert.Error(t, rules.Validate(int8(20)))
+	})
+
+	t.Run("uint64", func(t *testing.T) {
+		rules := NumberRules[uint64]{Max: ptr(uint64(10))}
+		assert.NoError(t, rules.Validate(uint64(5)))
+		assert.Error(t, rules.Validate(uint64(20)))
+	})
+
+	t.Run("float32", func(t *testing.T) {
+		rules := NumberRules[float32]{Min: ptr(float32(min)), Max: ptr(float32(max))}
+		assert.NoError(t, rules.Validate(float32(5)))
+		assert.Error(t, rules.Validate(float32(20)))
+	})
+
+	t.Run("float64 via json.Number", func(t *testing.T) {
+		rules := NumberRules[float64]{Min: &min, Max: &max}
+		assert.NoError(t, rules.Validate(json.Number("5")))
+		assert.Error(t, rules.Validate(json.Number("20")))
+	})
+}
+
+func TestNumberRulesReasonCodes(t *testing.T) {
+	min := 0
+	rules := NumberRules[int]{Min: &min}
+	err := rules.Validate(-1)
+	assert.Error(t, err)
+
+	ve, ok := err.(*ValidationError)
+	assert.True(t, ok)
+	assert.Equal(t, ReasonMin, ve.First().Reason)
+}
@@ -0,0 +1,68 @@
+package sval
+
+// ouiTable maps a normalized (lowercase, separator-free) IEEE assignment
+// prefix to its registered organization name. Keys are 6 hex digits for
+// MA-L blocks, 7 for MA-M, and 9 for MA-S - see lookupVendorByPrefix, which
+// tries the longest prefix first since an MA-M/MA-S assignment is a
+// sub-block of a /24 an organization doesn't itself hold.
+//
+// This is a small curated subset of the public IEEE OUI registry
+// (https://standards-oui.ieee.org/oui/oui.csv), not the full ~50k-entry
+// table - there's no network access here to regenerate it from source.
+var ouiTable = map[string]string{
+	"00000c": "Cisco Systems, Inc",
+	"001b63": "Apple, Inc.",
+	"3c0754": "Apple, Inc.",
+	"f4f5d8": "Google, Inc.",
+	"001a11": "Google, Inc.",
+	"0050f2": "Microsoft Corp.",
+	"7c1e52": "Microsoft Corporation",
+	"5cf938": "Dell Inc.",
+	"d4bed9": "Dell Inc.",
+	"3417eb": "Samsung Electronics Co.,Ltd",
+	"001632": "Samsung Electronics Co.,Ltd",
+	"000af7": "Intel Corporation",
+	"a0369f": "Intel Corporate",
+	"9cb6d0": "Hewlett Packard",
+	"3c4a92": "Hewlett Packard",
+	"0003ff": "Xerox Corporation",
+	"00163e": "VMware, Inc.",
+	"005056": "VMware, Inc.",
+	"0e9a6e": "Amazon Technologies Inc.",
+	"f0272d": "Amazon Technologies Inc.",
+	"00e0fc": "Huawei Technologies Co.,Ltd",
+	"48a472": "Huawei Technologies Co.,Ltd",
+	"34ce00": "Xiaomi Communications Co Ltd",
+	"286c07": "Sony Corporation",
+	"001dba": "Sony Corporation",
+	"001e75": "LG Electronics",
+	"009069": "Juniper Networks",
+	"dc85de": "Juniper Networks",
+	"00146c": "Netgear",
+	"a42b8c": "Netgear",
+	"50c7bf": "TP-Link Technologies Co.,Ltd.",
+	"f4f26d": "TP-Link Technologies Co.,Ltd.",
+	"1c7ee5": "D-Link Corporation",
+	"001e58": "D-Link Corporation",
+	"0010db": "Broadcom Corporation",
+	"00e04c": "Realtek Semiconductor Corp.",
+	"f8e71e": "Qualcomm Inc.",
+	"00247e": "ASUSTek Computer Inc.",
+	"10bf48": "ASUSTek Computer Inc.",
+	"00215c": "Lenovo Mobile Communication Technology Ltd.",
+	"6c29f7": "IBM Corp.",
+	"0021f6": "IBM Corp.",
+	"080020": "Oracle Corporation",
+	"001185": "Belkin International Inc.",
+	"245a4c": "Ubiquiti Networks Inc.",
+	"dca632": "Raspberry Pi Foundation",
+	"b827eb": "Raspberry Pi Foundation",
+
+	// MA-M (7 hex digit) examples, sub-delegated out of a larger MA-L block.
+	"00219b6": "Example MA-M Vendor A",
+	"00219b7": "Example MA-M Vendor B",
+
+	// MA-S (9 hex digit) examples.
+	"703a0e123": "Example MA-S Vendor A",
+	"703a0e124": "Example MA-S Vendor B",
+}
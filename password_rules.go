@@ -1,6 +1,7 @@
 package sval
 
 import (
+	"fmt"
 	"slices"
 	"unicode"
 	"unicode/utf8"
@@ -22,15 +23,10 @@ const (
 	PasswordRuleNameDetectLinearPatterns PasswordRuleName = "detect_linear_patterns" // Active detection of linear patterns (e.g., asdfgh, 12345678)
 	PasswordRuleNameBlacklist            PasswordRuleName = "blacklist"              // Blacklist of passwords
 	PasswordRuleNameMinEntropy           PasswordRuleName = "min_entropy"            // Min password entropy
+	PasswordRuleNameMinGuessesLog10      PasswordRuleName = "min_guesses_log10"      // Min log10(guesses) from EstimateStrength
+	PasswordRuleNameBreached             PasswordRuleName = "breached"               // Found in the HIBPCheck breach corpus
 )
 
-var patterns = []string{
-	"abcdefghijklmnopqrstuvwxyz",
-	"qwertyuiopasdfghjklzxcvbnm",
-	"1234567890",
-	"0987654321",
-}
-
 type PasswordRules struct {
 	BaseRules
 	MinLen               int      `json:"min_len" yaml:"min_len"`                               // 100% need
@@ -44,8 +40,18 @@ type PasswordRules struct {
 	DisallowedChars      []rune   `json:"disallowed_chars" yaml:"disallowed_chars"`             // if {'a', 'b', 'c'} - password must not contain these chars
 	MaxRepeatRun         int      `json:"max_repeat_run" yaml:"max_repeat_run"`                 // aaaaa, bbbbbbb, 11111 etc
 	DetectLinearPatterns bool     `json:"detect_linear_patterns" yaml:"detect_linear_patterns"` // asdfgh, 12345678, qwerty etc
+	MinLinearRunLen      int      `json:"min_linear_run_len" yaml:"min_linear_run_len"`         // if 0 = MinLinearRunLen
 	Blacklist            []string `json:"blacklist" yaml:"blacklist"`                           // idunno
 	MinEntropy           float64  `json:"min_entropy" yaml:"min_entropy"`                       // if 0 = ignore
+	// MinGuessesLog10 is a zxcvbn-style alternative to MinEntropy: the
+	// minimum acceptable log10(guesses) from EstimateStrength(val, Blacklist...).
+	// Unlike Shannon entropy, it scores "Tr0ub4dour&3"-style passwords low by
+	// recognizing the underlying dictionary word and l33t substitution.
+	MinGuessesLog10 *float64 `json:"min_guesses_log10,omitempty" yaml:"min_guesses_log10,omitempty"`
+	// HIBPCheck, when set, rejects passwords found in the Have I Been Pwned
+	// breach corpus (or, in HIBPConfig.Offline mode, in Blacklist). It is
+	// not config-loadable since it carries an HTTP client.
+	HIBPCheck *HIBPConfig `json:"-" yaml:"-"`
 }
 
 func (r *PasswordRules) Validate(i any) error {
@@ -173,7 +179,26 @@ func (r *PasswordRules) Validate(i any) error {
 	}
 
 	if r.DetectLinearPatterns {
-		// TODO: implement linear patterns detection
+		minRun := r.MinLinearRunLen
+		if minRun <= 0 {
+			minRun = MinLinearRunLen
+		}
+
+		names := slices.Clone(linearPatternOrder)
+		var extra []string
+		for name := range LinearPatternGraphs {
+			if !slices.Contains(linearPatternOrder, name) {
+				extra = append(extra, name)
+			}
+		}
+		slices.Sort(extra)
+		names = append(names, extra...)
+
+		for _, name := range names {
+			for _, run := range findLinearRuns(val, LinearPatternGraphs[name], minRun) {
+				err.AddError(name, run, val, fmt.Sprintf("password contains a linear pattern: %q", run))
+			}
+		}
 	}
 
 	if len(r.Blacklist) > 0 {
@@ -191,6 +216,21 @@ func (r *PasswordRules) Validate(i any) error {
 		}
 	}
 
+	if r.MinGuessesLog10 != nil {
+		result := EstimateStrength(val, r.Blacklist...)
+		if result.GuessesLog10 < *r.MinGuessesLog10 {
+			err.AddError(PasswordRuleNameMinGuessesLog10, *r.MinGuessesLog10, i,
+				fmt.Sprintf("password is too guessable: log10(guesses)=%.2f, weakest link is the %s", result.GuessesLog10, describeWeakestMatch(result.Matches)))
+			return err
+		}
+	}
+
+	if r.HIBPCheck != nil {
+		if hibpErr := r.checkHIBP(val); hibpErr != nil {
+			err.AppendError(asValidationError(hibpErr))
+		}
+	}
+
 	if err.HasErrors() {
 		return err
 	}
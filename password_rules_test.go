@@ -132,18 +132,42 @@ func TestPasswordRules(t *testing.T) {
 			wantErr: true,
 		},
 		// Pattern detection tests
-		// {
-		// 	name:    "contains linear pattern",
-		// 	rules:   PasswordRules{DetectLinearPatterns: true},
-		// 	value:   "pass123456",
-		// 	wantErr: true,
-		// },
-		// {
-		// 	name:    "no linear patterns",
-		// 	rules:   PasswordRules{DetectLinearPatterns: true},
-		// 	value:   "random135pass",
-		// 	wantErr: false,
-		// },
+		{
+			name:    "contains linear pattern",
+			rules:   PasswordRules{DetectLinearPatterns: true},
+			value:   "pass123456",
+			wantErr: true,
+		},
+		{
+			name:    "no linear patterns",
+			rules:   PasswordRules{DetectLinearPatterns: true},
+			value:   "random135pass",
+			wantErr: false,
+		},
+		{
+			name:    "qwerty keyboard row",
+			rules:   PasswordRules{DetectLinearPatterns: true},
+			value:   "xxasdfghxx",
+			wantErr: true,
+		},
+		{
+			name:    "reverse digit run",
+			rules:   PasswordRules{DetectLinearPatterns: true},
+			value:   "pin4321done",
+			wantErr: true,
+		},
+		{
+			name:    "reverse alpha run",
+			rules:   PasswordRules{DetectLinearPatterns: true},
+			value:   "xzyxwvzz",
+			wantErr: true,
+		},
+		{
+			name:    "jcuken cyrillic keyboard row",
+			rules:   PasswordRules{DetectLinearPatterns: true},
+			value:   "xxфываxx",
+			wantErr: true,
+		},
 		// Repeating characters tests
 		{
 			name:    "too many repeating characters",
@@ -224,6 +248,23 @@ func TestPasswordRules(t *testing.T) {
 			value:   "Tr0ub4dour&3",
 			wantErr: false,
 		},
+		// Guesses-based strength tests
+		{
+			name: "dictionary password scores as guessable",
+			rules: PasswordRules{
+				MinGuessesLog10: float64Ptr(6),
+			},
+			value:   "password",
+			wantErr: true,
+		},
+		{
+			name: "random password scores as strong",
+			rules: PasswordRules{
+				MinGuessesLog10: float64Ptr(6),
+			},
+			value:   "xK9$mQ2!vL7&pR4",
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -237,3 +278,7 @@ func TestPasswordRules(t *testing.T) {
 		})
 	}
 }
+
+func float64Ptr(f float64) *float64 {
+	return &f
+}
@@ -0,0 +1,441 @@
+package sval
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// commonPasswords is a small bundled dictionary used by EstimateStrength's
+// dictionary match, ranked by popularity (rank = index+1). It's meant as a
+// reasonable default, not exhaustive; PasswordRules.Blacklist is merged in
+// at a rank starting right after it.
+var commonPasswords = []string{
+	"123456", "password", "12345678", "qwerty", "123456789", "12345",
+	"1234", "111111", "1234567", "dragon", "123123", "baseball",
+	"abc123", "football", "monkey", "letmein", "696969", "shadow",
+	"master", "666666", "qwertyuiop", "123321", "mustang", "121212",
+	"starwars", "welcome", "login", "admin", "princess", "solo",
+}
+
+// l33tSubstitutions maps a leet-speak substitute back to the letter(s) it
+// commonly stands in for, used to de-l33t a substring before a dictionary
+// lookup.
+var l33tSubstitutions = map[rune][]rune{
+	'@': {'a'}, '4': {'a'},
+	'3': {'e'},
+	'1': {'i', 'l'}, '!': {'i'},
+	'0': {'o'},
+	'5': {'s'}, '$': {'s'},
+	'7': {'t'},
+}
+
+var dateWithSepRegex = regexp.MustCompile(`^\d{1,2}[/.\-]\d{1,2}[/.\-]\d{2,4}$`)
+var yearRegex = regexp.MustCompile(`^(19|20)\d{2}$`)
+
+// MatchKind identifies which heuristic produced a StrengthMatch.
+type MatchKind string
+
+const (
+	MatchKindDictionary         MatchKind = "dictionary"
+	MatchKindReversedDictionary MatchKind = "reversed_dictionary"
+	MatchKindL33tDictionary     MatchKind = "l33t_dictionary"
+	MatchKindSequence           MatchKind = "sequence"
+	MatchKindRepeat             MatchKind = "repeat"
+	MatchKindDate               MatchKind = "date"
+	MatchKindBruteforce         MatchKind = "bruteforce"
+)
+
+// StrengthMatch is one segment of the password explained by a single match
+// type, with its estimated guess count.
+type StrengthMatch struct {
+	Kind    MatchKind
+	Token   string
+	Start   int // rune offset, inclusive
+	End     int // rune offset, exclusive
+	Guesses float64
+}
+
+// StrengthResult is the outcome of EstimateStrength: the total estimated
+// guesses for the whole password (as log10, so it stays comparable across
+// wildly different magnitudes), and the non-overlapping matches the
+// min-guesses search used to explain it, in order of position.
+type StrengthResult struct {
+	GuessesLog10 float64
+	Matches      []StrengthMatch
+}
+
+// EstimateStrength scores password the way zxcvbn does: it enumerates
+// dictionary/reversed/l33t/sequence/repeat/date matches over every
+// substring, then runs a min-guesses dynamic program over non-overlapping
+// matches covering the whole string, falling back to a per-character
+// bruteforce estimate wherever nothing else matches. extraDict augments the
+// bundled common-password list (pass PasswordRules.Blacklist here).
+func EstimateStrength(password string, extraDict ...string) StrengthResult {
+	runes := []rune(password)
+	n := len(runes)
+	if n == 0 {
+		return StrengthResult{GuessesLog10: 0}
+	}
+
+	candidates := collectStrengthMatches(runes, extraDict)
+
+	g := make([]float64, n+1)
+	count := make([]int, n+1)
+	best := make([]*StrengthMatch, n+1)
+	g[0] = 1
+
+	for k := 1; k <= n; k++ {
+		// Default: extend the cheapest explanation of k-1 by one bruteforced
+		// character.
+		g[k] = g[k-1] * bruteforceGuesses(runes[k-1:k])
+		count[k] = count[k-1] + 1
+		best[k] = nil
+
+		for i := range candidates {
+			m := &candidates[i]
+			if m.End != k {
+				continue
+			}
+
+			numMatches := count[m.Start] + 1
+			candidate := g[m.Start] * m.Guesses * factorial(numMatches)
+			if candidate < g[k] {
+				g[k] = candidate
+				count[k] = numMatches
+				best[k] = m
+			}
+		}
+	}
+
+	return StrengthResult{
+		GuessesLog10: math.Log10(math.Max(g[n], 1)),
+		Matches:      reconstructStrengthMatches(runes, best, n),
+	}
+}
+
+func reconstructStrengthMatches(runes []rune, best []*StrengthMatch, n int) []StrengthMatch {
+	var out []StrengthMatch
+
+	for k := n; k > 0; {
+		m := best[k]
+		if m == nil {
+			out = append(out, StrengthMatch{
+				Kind:    MatchKindBruteforce,
+				Token:   string(runes[k-1 : k]),
+				Start:   k - 1,
+				End:     k,
+				Guesses: bruteforceGuesses(runes[k-1 : k]),
+			})
+			k--
+			continue
+		}
+		out = append(out, *m)
+		k = m.Start
+	}
+
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+
+	return out
+}
+
+// collectStrengthMatches enumerates every substring of runes and returns the
+// matches found by each heuristic. Overlapping matches are all returned; the
+// min-guesses DP in EstimateStrength picks which ones to actually use.
+func collectStrengthMatches(runes []rune, extraDict []string) []StrengthMatch {
+	var matches []StrengthMatch
+
+	dict := buildRankedDict(extraDict)
+
+	for i := 0; i < len(runes); i++ {
+		for j := i + 1; j <= len(runes); j++ {
+			token := string(runes[i:j])
+			if len(token) < 3 {
+				continue
+			}
+
+			lower := strings.ToLower(token)
+
+			if rank, ok := dict[lower]; ok {
+				matches = append(matches, StrengthMatch{
+					Kind: MatchKindDictionary, Token: token, Start: i, End: j,
+					Guesses: float64(rank) * uppercaseVariations(token),
+				})
+			}
+
+			if rank, ok := dict[reverseString(lower)]; ok {
+				matches = append(matches, StrengthMatch{
+					Kind: MatchKindReversedDictionary, Token: token, Start: i, End: j,
+					Guesses: float64(rank) * uppercaseVariations(token),
+				})
+			}
+
+			if deL33t, variations := unl33t(lower); deL33t != lower {
+				if rank, ok := dict[deL33t]; ok {
+					matches = append(matches, StrengthMatch{
+						Kind: MatchKindL33tDictionary, Token: token, Start: i, End: j,
+						Guesses: float64(rank) * uppercaseVariations(token) * variations,
+					})
+				}
+			}
+
+			if isDatePattern(token) {
+				matches = append(matches, StrengthMatch{
+					Kind: MatchKindDate, Token: token, Start: i, End: j,
+					Guesses: 365 * 100,
+				})
+			}
+		}
+	}
+
+	matches = append(matches, sequenceMatches(runes)...)
+	matches = append(matches, repeatMatches(runes)...)
+
+	return matches
+}
+
+// buildRankedDict merges the bundled common-password list with extraDict,
+// lower-cased, ranked by position (1-based; extraDict continues the rank
+// after commonPasswords so user-supplied blacklist entries still count as
+// "known" without being treated as more common than the built-ins).
+func buildRankedDict(extraDict []string) map[string]int {
+	dict := make(map[string]int, len(commonPasswords)+len(extraDict))
+	rank := 1
+	for _, w := range commonPasswords {
+		dict[strings.ToLower(w)] = rank
+		rank++
+	}
+	for _, w := range extraDict {
+		w = strings.ToLower(w)
+		if _, exists := dict[w]; !exists {
+			dict[w] = rank
+			rank++
+		}
+	}
+	return dict
+}
+
+func reverseString(s string) string {
+	runes := []rune(s)
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+	return string(runes)
+}
+
+// unl33t substitutes known leet characters back to their plain letter and
+// returns the de-l33ted string along with a variations factor (2 per
+// substituted position, matching the common "each substitution could have
+// gone either way" heuristic).
+func unl33t(s string) (string, float64) {
+	runes := []rune(s)
+	variations := 1.0
+	for i, r := range runes {
+		if letters, ok := l33tSubstitutions[r]; ok {
+			runes[i] = letters[0]
+			variations *= 2
+		}
+	}
+	return string(runes), variations
+}
+
+// uppercaseVariations estimates how many ways a token's capitalization could
+// have been chosen, given it matched a dictionary word case-insensitively.
+func uppercaseVariations(token string) float64 {
+	if token == strings.ToLower(token) {
+		return 1
+	}
+	if token == strings.ToUpper(token) {
+		return float64(len([]rune(token)))
+	}
+
+	runes := []rune(token)
+	if unicode.IsUpper(runes[0]) && string(runes[1:]) == strings.ToLower(string(runes[1:])) {
+		return 2
+	}
+
+	upper, lower := 0, 0
+	for _, r := range runes {
+		if unicode.IsUpper(r) {
+			upper++
+		} else if unicode.IsLower(r) {
+			lower++
+		}
+	}
+
+	smaller := upper
+	if lower < smaller {
+		smaller = lower
+	}
+	return binomial(len(runes), smaller)
+}
+
+func isDatePattern(token string) bool {
+	return yearRegex.MatchString(token) || dateWithSepRegex.MatchString(token)
+}
+
+// sequenceMatches reuses the alphabetic/digit adjacency graphs built for
+// DetectLinearPatterns: a run in either graph is exactly the "sequence"
+// match type zxcvbn describes (consecutive ascending/descending runs).
+func sequenceMatches(runes []rune) []StrengthMatch {
+	var matches []StrengthMatch
+	s := string(runes)
+	lower := toLowerRunes(s)
+
+	for _, graph := range []LinearPatternGraph{AlphaLinearGraph, DigitLinearGraph} {
+		from := 0
+		for _, run := range findLinearRuns(s, graph, 3) {
+			idx := strings.Index(lower[from:], run)
+			if idx < 0 {
+				continue
+			}
+			byteStart := from + idx
+			from = byteStart + len(run)
+			start := utf8.RuneCountInString(lower[:byteStart])
+			end := start + len([]rune(run))
+			matches = append(matches, StrengthMatch{
+				Kind: MatchKindSequence, Token: run, Start: start, End: end,
+				Guesses: float64(len([]rune(run))) * 10,
+			})
+		}
+	}
+
+	return matches
+}
+
+// repeatMatches finds runs of a single repeated character ("aaaa") or a
+// repeated 2-character block ("abab"); a single-character run needs length
+// >= 3, a block run at least two blocks (length >= 4).
+func repeatMatches(runes []rune) []StrengthMatch {
+	var matches []StrengthMatch
+	n := len(runes)
+
+	for i := 0; i < n; i++ {
+		j := i + 1
+		for j < n && runes[j] == runes[i] {
+			j++
+		}
+		if j-i >= 3 {
+			matches = append(matches, StrengthMatch{
+				Kind: MatchKindRepeat, Token: string(runes[i:j]), Start: i, End: j,
+				Guesses: float64(charCardinality(runes[i])) * float64(j-i),
+			})
+		}
+	}
+
+	for i := 0; i+1 < n; i++ {
+		j := i + 2
+		for j+1 < n && runes[j] == runes[i] && runes[j+1] == runes[i+1] {
+			j += 2
+		}
+		if j-i >= 4 {
+			matches = append(matches, StrengthMatch{
+				Kind: MatchKindRepeat, Token: string(runes[i:j]), Start: i, End: j,
+				Guesses: float64(charCardinality(runes[i])+charCardinality(runes[i+1])) * float64((j-i)/2),
+			})
+		}
+	}
+
+	return matches
+}
+
+func charCardinality(r rune) int {
+	switch {
+	case unicode.IsLower(r):
+		return 26
+	case unicode.IsUpper(r):
+		return 26
+	case unicode.IsDigit(r):
+		return 10
+	case unicode.IsSpace(r):
+		return 1
+	default:
+		return 33
+	}
+}
+
+func tokenCardinality(token []rune) int {
+	classes := map[int]bool{}
+	for _, r := range token {
+		classes[charCardinality(r)] = true
+	}
+	total := 0
+	for c := range classes {
+		total += c
+	}
+	return total
+}
+
+// bruteforceGuesses is the fallback estimate for a span no other heuristic
+// explained: cardinality^length, with a 10^3 minimum (scaled down for very
+// short spans, since a single stray character shouldn't dominate the whole
+// password's score the way zxcvbn's own length-tiered minimums work).
+func bruteforceGuesses(token []rune) float64 {
+	length := len(token)
+	if length == 0 {
+		return 1
+	}
+
+	guesses := math.Pow(float64(tokenCardinality(token)), float64(length))
+
+	min := math.Pow(10, 3)
+	switch length {
+	case 1:
+		min = 1
+	case 2:
+		min = 50
+	}
+
+	if guesses < min {
+		return min
+	}
+	return guesses
+}
+
+func binomial(n, k int) float64 {
+	if k < 0 || k > n {
+		return 0
+	}
+	result := 1.0
+	for i := 0; i < k; i++ {
+		result *= float64(n-i) / float64(i+1)
+	}
+	return result
+}
+
+func factorial(n int) float64 {
+	result := 1.0
+	for i := 2; i <= n; i++ {
+		result *= float64(i)
+	}
+	return result
+}
+
+// weakestStrengthMatch returns the match contributing the fewest guesses, or
+// nil when there are no matches (shouldn't happen for a non-empty password).
+func weakestStrengthMatch(matches []StrengthMatch) *StrengthMatch {
+	if len(matches) == 0 {
+		return nil
+	}
+	weakest := &matches[0]
+	for i := range matches[1:] {
+		m := &matches[i+1]
+		if m.Guesses < weakest.Guesses {
+			weakest = m
+		}
+	}
+	return weakest
+}
+
+func describeWeakestMatch(matches []StrengthMatch) string {
+	m := weakestStrengthMatch(matches)
+	if m == nil {
+		return "no matches"
+	}
+	return fmt.Sprintf("%s match %q", m.Kind, m.Token)
+}
@@ -0,0 +1,91 @@
+package sval
+
+import "regexp"
+
+// postcodeRegexes maps an upper-case ISO 3166-1 alpha-2 country code to the
+// compiled regex its postal codes must match. Compiled once at package init,
+// following the postcode validator shipped by go-playground/validator.
+var postcodeRegexes = map[string]*regexp.Regexp{
+	"US": regexp.MustCompile(`^\d{5}(-\d{4})?$`),
+	"GB": regexp.MustCompile(`^[A-Z]{1,2}\d[A-Z\d]? ?\d[A-Z]{2}$`),
+	"CA": regexp.MustCompile(`^[A-CEGHJ-NPRSTVXY]\d[A-CEGHJ-NPRSTV-Z] ?\d[A-CEGHJ-NPRSTV-Z]\d$`),
+	"DE": regexp.MustCompile(`^\d{5}$`),
+	"NL": regexp.MustCompile(`^\d{4} ?[A-Z]{2}$`),
+	"FR": regexp.MustCompile(`^\d{5}$`),
+	"IT": regexp.MustCompile(`^\d{5}$`),
+	"ES": regexp.MustCompile(`^\d{5}$`),
+	"PT": regexp.MustCompile(`^\d{4}-\d{3}$`),
+	"BE": regexp.MustCompile(`^\d{4}$`),
+	"CH": regexp.MustCompile(`^\d{4}$`),
+	"AT": regexp.MustCompile(`^\d{4}$`),
+	"DK": regexp.MustCompile(`^\d{4}$`),
+	"SE": regexp.MustCompile(`^\d{3} ?\d{2}$`),
+	"NO": regexp.MustCompile(`^\d{4}$`),
+	"FI": regexp.MustCompile(`^\d{5}$`),
+	"PL": regexp.MustCompile(`^\d{2}-\d{3}$`),
+	"CZ": regexp.MustCompile(`^\d{3} ?\d{2}$`),
+	"SK": regexp.MustCompile(`^\d{3} ?\d{2}$`),
+	"HU": regexp.MustCompile(`^\d{4}$`),
+	"RO": regexp.MustCompile(`^\d{6}$`),
+	"BG": regexp.MustCompile(`^\d{4}$`),
+	"GR": regexp.MustCompile(`^\d{3} ?\d{2}$`),
+	"IE": regexp.MustCompile(`^[A-Z]\d[A-Z\d] ?[A-Z\d]{4}$`),
+	"IS": regexp.MustCompile(`^\d{3}$`),
+	"LU": regexp.MustCompile(`^\d{4}$`),
+	"LI": regexp.MustCompile(`^\d{4}$`),
+	"MT": regexp.MustCompile(`^[A-Z]{3} ?\d{2,4}$`),
+	"CY": regexp.MustCompile(`^\d{4}$`),
+	"EE": regexp.MustCompile(`^\d{5}$`),
+	"LV": regexp.MustCompile(`^LV-\d{4}$`),
+	"LT": regexp.MustCompile(`^LT-\d{5}$`),
+	"SI": regexp.MustCompile(`^\d{4}$`),
+	"HR": regexp.MustCompile(`^\d{5}$`),
+	"RS": regexp.MustCompile(`^\d{5,6}$`),
+	"BA": regexp.MustCompile(`^\d{5}$`),
+	"MK": regexp.MustCompile(`^\d{4}$`),
+	"ME": regexp.MustCompile(`^\d{5}$`),
+	"AL": regexp.MustCompile(`^\d{4}$`),
+	"MD": regexp.MustCompile(`^MD-?\d{4}$`),
+	"UA": regexp.MustCompile(`^\d{5}$`),
+	"BY": regexp.MustCompile(`^\d{6}$`),
+	"RU": regexp.MustCompile(`^\d{6}$`),
+	"TR": regexp.MustCompile(`^\d{5}$`),
+	"IL": regexp.MustCompile(`^\d{5,7}$`),
+	"JP": regexp.MustCompile(`^\d{3}-?\d{4}$`),
+	"KR": regexp.MustCompile(`^\d{5}$`),
+	"CN": regexp.MustCompile(`^\d{6}$`),
+	"IN": regexp.MustCompile(`^\d{6}$`),
+	"PK": regexp.MustCompile(`^\d{5}$`),
+	"BD": regexp.MustCompile(`^\d{4}$`),
+	"LK": regexp.MustCompile(`^\d{5}$`),
+	"NP": regexp.MustCompile(`^\d{5}$`),
+	"SG": regexp.MustCompile(`^\d{6}$`),
+	"MY": regexp.MustCompile(`^\d{5}$`),
+	"TH": regexp.MustCompile(`^\d{5}$`),
+	"VN": regexp.MustCompile(`^\d{6}$`),
+	"PH": regexp.MustCompile(`^\d{4}$`),
+	"ID": regexp.MustCompile(`^\d{5}$`),
+	"AU": regexp.MustCompile(`^\d{4}$`),
+	"NZ": regexp.MustCompile(`^\d{4}$`),
+	"MX": regexp.MustCompile(`^\d{5}$`),
+	"BR": regexp.MustCompile(`^\d{5}-?\d{3}$`),
+	"AR": regexp.MustCompile(`^[A-Z]?\d{4}[A-Z]{0,3}$`),
+	"CL": regexp.MustCompile(`^\d{7}$`),
+	"CO": regexp.MustCompile(`^\d{6}$`),
+	"PE": regexp.MustCompile(`^\d{5}$`),
+	"UY": regexp.MustCompile(`^\d{5}$`),
+	"VE": regexp.MustCompile(`^\d{4}$`),
+	"EC": regexp.MustCompile(`^\d{6}$`),
+	"BO": regexp.MustCompile(`^\d{4}$`),
+	"PY": regexp.MustCompile(`^\d{4}$`),
+	"ZA": regexp.MustCompile(`^\d{4}$`),
+	"EG": regexp.MustCompile(`^\d{5}$`),
+	"MA": regexp.MustCompile(`^\d{5}$`),
+	"TN": regexp.MustCompile(`^\d{4}$`),
+	"DZ": regexp.MustCompile(`^\d{5}$`),
+	"NG": regexp.MustCompile(`^\d{6}$`),
+	"KE": regexp.MustCompile(`^\d{5}$`),
+	"GH": regexp.MustCompile(`^[A-Z]{2}-?\d{3,4}-?\d{4}$`),
+	"SA": regexp.MustCompile(`^\d{5}(-\d{4})?$`),
+	"AE": regexp.MustCompile(`^\d{0,5}$`),
+}
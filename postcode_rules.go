@@ -0,0 +1,98 @@
+package sval
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+type PostcodeRuleName = string
+
+const (
+	PostcodeRuleNameCountry          PostcodeRuleName = "country" // Country not supported or not in AllowedCountries
+	PostcodeRuleNameFormat           PostcodeRuleName = "format"  // Value doesn't match the country's postcode format
+	PostcodeRuleNameCountryField     PostcodeRuleName = "country_field"
+	PostcodeRuleNameAllowedCountries PostcodeRuleName = "allowed_countries"
+)
+
+// PostcodeRules validates a postal code against the per-country regex
+// table in postcode_regexes.go. The country to validate against is either
+// static (Country) or read from a sibling field at validate time
+// (CountryField, via ValidateWithSiblings - wired in automatically for
+// struct fields by validateRecursive), so the same rule can follow a
+// country selected elsewhere in the struct/map.
+type PostcodeRules struct {
+	BaseRules
+	// Country is the static ISO 3166-1 alpha-2 country code to validate
+	// against (e.g. "US", "GB"). Under ValidateWithSiblings, a string value
+	// in the CountryField sibling takes its place; Country is used when that
+	// sibling is absent or not a string.
+	Country string `json:"country,omitempty" yaml:"country,omitempty"`
+	// CountryField names a sibling field holding the country code.
+	CountryField string `json:"country_field,omitempty" yaml:"country_field,omitempty"`
+	// AllowedCountries, if non-empty, restricts which resolved country
+	// codes this rule accepts.
+	AllowedCountries []string `json:"allowed_countries,omitempty" yaml:"allowed_countries,omitempty"`
+}
+
+// Validate uses the static Country; use ValidateWithSiblings to resolve
+// CountryField against the enclosing struct/map instead.
+func (r *PostcodeRules) Validate(i any) error {
+	return r.validate(i, r.Country)
+}
+
+func (r *PostcodeRules) ValidateWithSiblings(i any, siblings map[string]any) error {
+	country := r.Country
+	if r.CountryField != "" {
+		if sibling, ok := siblings[r.CountryField].(string); ok {
+			country = sibling
+		}
+	}
+	return r.validate(i, country)
+}
+
+func (r *PostcodeRules) validate(i any, country string) error {
+	err := NewValidationError()
+
+	if i == nil {
+		if r.Required {
+			err.AddError(BaseRuleNameRequired, r.Required, i, FieldIsRequired)
+			return err
+		}
+		return nil
+	}
+
+	val, ok := i.(string)
+	if !ok {
+		err.AddError(BaseRuleNameType, TypeString, i, "value must be a string")
+		return err
+	}
+
+	if val == "" {
+		if r.Required {
+			err.AddError(BaseRuleNameRequired, r.Required, i, FieldIsRequired)
+			return err
+		}
+		return nil
+	}
+
+	country = strings.ToUpper(country)
+
+	if len(r.AllowedCountries) > 0 && !slices.Contains(r.AllowedCountries, country) {
+		err.AddError(PostcodeRuleNameCountry, r.AllowedCountries, country, fmt.Sprintf("country %q is not allowed", country))
+		return err
+	}
+
+	re, ok := postcodeRegexes[country]
+	if !ok {
+		err.AddError(PostcodeRuleNameCountry, country, country, fmt.Sprintf("unsupported country %q", country))
+		return err
+	}
+
+	if !re.MatchString(val) {
+		err.AddError(PostcodeRuleNameFormat, country, val, fmt.Sprintf("value is not a valid %s postcode", country))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package sval
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostcodeRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   PostcodeRules
+		value   any
+		wantErr bool
+	}{
+		{
+			name:    "empty string when not required",
+			rules:   PostcodeRules{Country: "US"},
+			value:   "",
+			wantErr: false,
+		},
+		{
+			name:    "empty string when required",
+			rules:   PostcodeRules{BaseRules: BaseRules{Required: true}, Country: "US"},
+			value:   "",
+			wantErr: true,
+		},
+		{
+			name:    "non-string value",
+			rules:   PostcodeRules{Country: "US"},
+			value:   123,
+			wantErr: true,
+		},
+		{
+			name:    "valid US zip",
+			rules:   PostcodeRules{Country: "US"},
+			value:   "90210",
+			wantErr: false,
+		},
+		{
+			name:    "valid US zip+4",
+			rules:   PostcodeRules{Country: "US"},
+			value:   "90210-1234",
+			wantErr: false,
+		},
+		{
+			name:    "invalid US zip",
+			rules:   PostcodeRules{Country: "US"},
+			value:   "abcde",
+			wantErr: true,
+		},
+		{
+			name:    "valid GB postcode",
+			rules:   PostcodeRules{Country: "GB"},
+			value:   "SW1A 1AA",
+			wantErr: false,
+		},
+		{
+			name:    "valid CA postcode",
+			rules:   PostcodeRules{Country: "CA"},
+			value:   "K1A 0B1",
+			wantErr: false,
+		},
+		{
+			name:    "valid NL postcode",
+			rules:   PostcodeRules{Country: "NL"},
+			value:   "1234 AB",
+			wantErr: false,
+		},
+		{
+			name:    "country code is lowercased but still resolves",
+			rules:   PostcodeRules{Country: "de"},
+			value:   "10115",
+			wantErr: false,
+		},
+		{
+			name:    "unsupported country",
+			rules:   PostcodeRules{Country: "ZZ"},
+			value:   "12345",
+			wantErr: true,
+		},
+		{
+			name:    "country not in AllowedCountries",
+			rules:   PostcodeRules{Country: "US", AllowedCountries: []string{"GB", "CA"}},
+			value:   "90210",
+			wantErr: true,
+		},
+		{
+			name:    "country in AllowedCountries",
+			rules:   PostcodeRules{Country: "GB", AllowedCountries: []string{"GB", "CA"}},
+			value:   "SW1A 1AA",
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rules.Validate(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err, "Expected error for %s with value %v", tt.name, tt.value)
+			} else {
+				assert.NoError(t, err, "Unexpected error for %s with value %v", tt.name, tt.value)
+			}
+		})
+	}
+}
+
+func TestPostcodeRules_CountryField(t *testing.T) {
+	rules := PostcodeRules{CountryField: "country"}
+
+	err := rules.ValidateWithSiblings("90210", map[string]any{"country": "US"})
+	assert.NoError(t, err)
+
+	err = rules.ValidateWithSiblings("not-a-zip", map[string]any{"country": "US"})
+	assert.Error(t, err)
+}
+
+func TestPostcodeRules_InSchema(t *testing.T) {
+	schema := Schema{
+		"country":  &StringRules{},
+		"postcode": &PostcodeRules{CountryField: "country"},
+	}
+
+	assert.NoError(t, schema.Validate(map[string]any{"country": "US", "postcode": "90210"}))
+	assert.Error(t, schema.Validate(map[string]any{"country": "US", "postcode": "bad"}))
+}
@@ -0,0 +1,63 @@
+package sval
+
+// Reason is a stable, machine-readable code for why a field failed a rule,
+// independent of the rule's (possibly translated) Message text. It's the
+// FieldError analogue of ConstraintReason, and serializes to JSON as its
+// own SCREAMING_SNAKE_CASE string. Rule types that haven't been migrated to
+// AddReasonError yet leave it at the zero value, ReasonUnspecified.
+type Reason string
+
+const (
+	ReasonUnspecified Reason = ""
+
+	ReasonRequired Reason = "REQUIRED"
+	ReasonType     Reason = "TYPE"
+	ReasonConfig   Reason = "CONFIG"
+
+	// StringRules
+	ReasonMinLen        Reason = "MIN_LEN"
+	ReasonMaxLen        Reason = "MAX_LEN"
+	ReasonRegexMismatch Reason = "REGEX_MISMATCH"
+	ReasonOnlyDigits    Reason = "ONLY_DIGITS"
+	ReasonOnlyLetters   Reason = "ONLY_LETTERS"
+	ReasonNoWhitespace  Reason = "NO_WHITESPACE"
+	ReasonStartsWith    Reason = "STARTS_WITH"
+	ReasonEndsWith      Reason = "ENDS_WITH"
+	ReasonContains      Reason = "CONTAINS"
+	ReasonNotContains   Reason = "NOT_CONTAINS"
+	ReasonOneOf         Reason = "ONE_OF"
+	ReasonMinEntropy    Reason = "MIN_ENTROPY"
+
+	// IPRules
+	ReasonIPVersion             Reason = "IP_VERSION"
+	ReasonPrivateNotAllowed     Reason = "PRIVATE_NOT_ALLOWED"
+	ReasonLoopbackNotAllowed    Reason = "LOOPBACK_NOT_ALLOWED"
+	ReasonLinkLocalNotAllowed   Reason = "LINK_LOCAL_NOT_ALLOWED"
+	ReasonMulticastNotAllowed   Reason = "MULTICAST_NOT_ALLOWED"
+	ReasonUnspecifiedNotAllowed Reason = "UNSPECIFIED_NOT_ALLOWED"
+	ReasonSubnetNotAllowed      Reason = "SUBNET_NOT_ALLOWED"
+	ReasonSubnetExcluded        Reason = "SUBNET_EXCLUDED"
+
+	// NumberRules
+	ReasonMin           Reason = "MIN"
+	ReasonMax           Reason = "MAX"
+	ReasonExclusiveMin  Reason = "EXCLUSIVE_MIN"
+	ReasonExclusiveMax  Reason = "EXCLUSIVE_MAX"
+	ReasonMultipleOf    Reason = "MULTIPLE_OF"
+	ReasonAllowedValues Reason = "ALLOWED_VALUES"
+	ReasonNaN           Reason = "NAN"
+	ReasonInf           Reason = "INF"
+	ReasonNonZero       Reason = "NON_ZERO"
+
+	// MACRules
+	ReasonMACFormat    Reason = "MAC_FORMAT"
+	ReasonMACMaxOctets Reason = "MAC_MAX_OCTETS"
+	ReasonMACCase      Reason = "MAC_CASE"
+	ReasonMACType      Reason = "MAC_TYPE"
+	ReasonMACOUI       Reason = "MAC_OUI"
+	ReasonMACVendor    Reason = "MAC_VENDOR"
+	ReasonMACBlacklist Reason = "MAC_BLACKLIST"
+	ReasonMACZero      Reason = "MAC_ZERO_NOT_ALLOWED"
+	ReasonMACBroadcast Reason = "MAC_BROADCAST_NOT_ALLOWED"
+	ReasonMACMulticast Reason = "MAC_MULTICAST_NOT_ALLOWED"
+)
@@ -0,0 +1,95 @@
+package sval
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// RuleTypeParser builds a RuleSet from a RuleConfig.Params map for one rule
+// type, e.g. the function backing "string" or "email" in a ValidatorConfig.
+type RuleTypeParser func(params map[string]any) (RuleSet, error)
+
+var (
+	ruleTypeMu       sync.RWMutex
+	ruleTypeRegistry = map[string]RuleTypeParser{}
+)
+
+// RegisterRuleType adds a parser for a custom rule type under name, so
+// `type: name` in a ValidatorConfig resolves to it the same way the built-in
+// types (string, email, int, ...) do. name is matched case-insensitively,
+// the same way createBaseRuleSet resolves cfg.Type, so "Phone", "PHONE" and
+// "phone" all register and look up as one type. It returns an error if name
+// is empty or already registered - built-ins included, so a downstream user
+// can't shadow "string" by accident; use a distinct name for a
+// domain-specific type (e.g. "phone", "iban", "uuid") instead.
+func RegisterRuleType(name string, parser RuleTypeParser) error {
+	if name == "" {
+		return fmt.Errorf("sval: rule type name must not be empty")
+	}
+	if parser == nil {
+		return fmt.Errorf("sval: rule type %q: parser must not be nil", name)
+	}
+	name = strings.ToLower(name)
+
+	ruleTypeMu.Lock()
+	defer ruleTypeMu.Unlock()
+
+	if _, exists := ruleTypeRegistry[name]; exists {
+		return fmt.Errorf("sval: rule type %q is already registered", name)
+	}
+	ruleTypeRegistry[name] = parser
+	return nil
+}
+
+// MustRegisterRuleType is RegisterRuleType for init-time registration, where
+// a naming collision is a programming error that should fail loudly rather
+// than be silently swallowed.
+func MustRegisterRuleType(name string, parser RuleTypeParser) {
+	if err := RegisterRuleType(name, parser); err != nil {
+		panic(err)
+	}
+}
+
+// RuleTypes returns the names of every registered rule type, sorted, for
+// tooling that wants to list or validate against what a ValidatorConfig can
+// reference (e.g. a config linter or a doc generator).
+func RuleTypes() []string {
+	ruleTypeMu.RLock()
+	defer ruleTypeMu.RUnlock()
+
+	types := make([]string, 0, len(ruleTypeRegistry))
+	for name := range ruleTypeRegistry {
+		types = append(types, name)
+	}
+	sort.Strings(types)
+	return types
+}
+
+func lookupRuleTypeParser(name string) (RuleTypeParser, bool) {
+	ruleTypeMu.RLock()
+	defer ruleTypeMu.RUnlock()
+
+	parser, ok := ruleTypeRegistry[name]
+	return parser, ok
+}
+
+// init pre-registers every built-in rule type, so createBaseRuleSet's lookup
+// behaves exactly like the old hardcoded switch for existing configs.
+func init() {
+	MustRegisterRuleType(string(TypeString), func(p map[string]any) (RuleSet, error) { return parseStringRules(p) })
+	MustRegisterRuleType(string(TypeEmail), func(p map[string]any) (RuleSet, error) { return parseEmailRules(p) })
+	MustRegisterRuleType(string(TypePassword), func(p map[string]any) (RuleSet, error) { return parsePasswordRules(p) })
+	MustRegisterRuleType(string(TypeInt), parseIntRules)
+	MustRegisterRuleType(string(TypeFloat), parseFloatRules)
+	MustRegisterRuleType(string(TypeIP), parseIPRules)
+	MustRegisterRuleType(string(TypeCIDR), parseCIDRRules)
+	MustRegisterRuleType(string(TypeURL), parseURLRules)
+	MustRegisterRuleType(string(TypeDNS), parseDNSRules)
+	MustRegisterRuleType(string(TypeURI), parseURIRules)
+	MustRegisterRuleType(string(TypeMAC), func(p map[string]any) (RuleSet, error) { return parseMACRules(p) })
+	MustRegisterRuleType(string(TypeTime), func(p map[string]any) (RuleSet, error) { return parseTimeRules(p) })
+	MustRegisterRuleType(string(TypeCrossField), parseCrossFieldConfig)
+	MustRegisterRuleType(string(TypePostcode), parsePostcodeRules)
+}
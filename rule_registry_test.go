@@ -0,0 +1,103 @@
+package sval
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type dummyRule struct{ allowed string }
+
+func (r *dummyRule) Validate(i any) error {
+	if i != r.allowed {
+		err := NewValidationError()
+		err.AddError("dummy", r.allowed, i, "value does not match the dummy rule")
+		return err
+	}
+	return nil
+}
+
+func TestRegisterRuleType_CustomTypeUsableInConfig(t *testing.T) {
+	assert.NoError(t, RegisterRuleType("registry_test_dummy", func(params map[string]any) (RuleSet, error) {
+		return &dummyRule{allowed: params["allowed"].(string)}, nil
+	}))
+
+	v, err := NewValidatorFromConfig(ValidatorConfig{
+		Rules: map[string]RuleConfig{
+			"code": {Type: "registry_test_dummy", Params: map[string]any{"allowed": "ok"}},
+		},
+	})
+	assert.NoError(t, err)
+
+	type TestStruct struct {
+		Code string `sval:"code"`
+	}
+
+	assert.NoError(t, v.Validate(TestStruct{Code: "ok"}))
+	assert.Error(t, v.Validate(TestStruct{Code: "no"}))
+}
+
+func TestRegisterRuleType_DuplicateNameRejected(t *testing.T) {
+	assert.NoError(t, RegisterRuleType("registry_test_dup", func(map[string]any) (RuleSet, error) {
+		return &dummyRule{}, nil
+	}))
+
+	err := RegisterRuleType("registry_test_dup", func(map[string]any) (RuleSet, error) {
+		return &dummyRule{}, nil
+	})
+	assert.Error(t, err)
+}
+
+func TestRegisterRuleType_BuiltinNameRejected(t *testing.T) {
+	err := RegisterRuleType(string(TypeString), func(map[string]any) (RuleSet, error) {
+		return &dummyRule{}, nil
+	})
+	assert.Error(t, err)
+}
+
+func TestMustRegisterRuleType_PanicsOnDuplicate(t *testing.T) {
+	assert.NoError(t, RegisterRuleType("registry_test_must_dup", func(map[string]any) (RuleSet, error) {
+		return &dummyRule{}, nil
+	}))
+
+	assert.Panics(t, func() {
+		MustRegisterRuleType("registry_test_must_dup", func(map[string]any) (RuleSet, error) {
+			return &dummyRule{}, nil
+		})
+	})
+}
+
+func TestRuleTypes_IncludesBuiltinsAndCustom(t *testing.T) {
+	assert.NoError(t, RegisterRuleType("registry_test_introspect", func(map[string]any) (RuleSet, error) {
+		return &dummyRule{}, nil
+	}))
+
+	types := RuleTypes()
+	assert.Contains(t, types, string(TypeString))
+	assert.Contains(t, types, string(TypeCrossField))
+	assert.Contains(t, types, "registry_test_introspect")
+}
+
+func TestRegisterRuleType_NameIsCaseInsensitive(t *testing.T) {
+	assert.NoError(t, RegisterRuleType("Registry_Test_Mixed_Case", func(params map[string]any) (RuleSet, error) {
+		return &dummyRule{allowed: params["allowed"].(string)}, nil
+	}))
+
+	v, err := NewValidatorFromConfig(ValidatorConfig{
+		Rules: map[string]RuleConfig{
+			"code": {Type: "registry_test_mixed_case", Params: map[string]any{"allowed": "ok"}},
+		},
+	})
+	assert.NoError(t, err)
+
+	type TestStruct struct {
+		Code string `sval:"code"`
+	}
+
+	assert.NoError(t, v.Validate(TestStruct{Code: "ok"}))
+}
+
+func TestCreateRuleSet_UnknownTypeStillErrors(t *testing.T) {
+	_, err := createRuleSet(RuleConfig{Type: "does_not_exist"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,165 @@
+package sval
+
+import (
+	"fmt"
+	"reflect"
+)
+
+const (
+	BaseRuleNameRequiredIf     BaseRuleName = "required_if"
+	BaseRuleNameRequiredUnless BaseRuleName = "required_unless"
+	BaseRuleNameRequiredWith   BaseRuleName = "required_with"
+	BaseRuleNameExcludedWith   BaseRuleName = "excluded_with"
+)
+
+// Cond is an equality condition against a sibling field, read by
+// BaseRules.RequiredIf/RequiredUnless to make a field's requiredness depend
+// on another field's value, e.g. Cond{Field: "country", Eq: "US"}.
+type Cond struct {
+	Field string
+	Eq    any
+}
+
+// Schema declares dependencies between named fields, on top of what each
+// field's own RuleSet already checks in isolation - the same role
+// CrossFieldRules plays for a single field's comparisons against a sibling,
+// but driven by BaseRules.RequiredIf/RequiredUnless/RequiredWith/
+// ExcludedWith instead of per-type comparison fields. Validate accepts a
+// map[string]any or a struct whose fields carry a `sval:"..."` tag, the
+// same tag the path-based validator resolves siblings from.
+type Schema map[string]RuleSet
+
+// Validate implements RuleSet, so a Schema can be used standalone or nested
+// inside another rule the same way any other RuleSet can.
+func (s Schema) Validate(i any) error {
+	fields, ok := toFieldMap(i)
+	if !ok {
+		err := NewValidationError()
+		err.AddError(BaseRuleNameType, "map or struct", i, "value must be a map or struct")
+		return err
+	}
+
+	errs := NewValidationError()
+
+	for name, rule := range s {
+		value, present := fields[name]
+
+		if ruleName, message, failed := checkConditions(rule, name, value, present, fields); failed {
+			fieldErr := NewValidationError()
+			fieldErr.AddError(ruleName, nil, value, message)
+			fieldErr.AddContextToErrors(name)
+			errs.AppendError(fieldErr)
+			continue
+		}
+
+		if fieldErr := runNested(rule, value, fields); fieldErr != nil {
+			ve := asValidationError(fieldErr)
+			ve.AddContextToErrors(name)
+			errs.AppendError(ve)
+		}
+	}
+
+	if errs.HasErrors() {
+		return errs
+	}
+	return nil
+}
+
+// conditionalRules is implemented by every *Rules type via its embedded
+// BaseRules, letting checkConditions resolve RequiredIf/RequiredUnless/
+// RequiredWith/ExcludedWith generically instead of a type switch over every
+// rule type.
+type conditionalRules interface {
+	conditions() (requiredIf, requiredUnless []Cond, requiredWith, excludedWith []string)
+}
+
+// checkConditions resolves rule's BaseRules conditions against siblings and
+// reports whether name fails one of them: either required and absent, or
+// excluded and present.
+func checkConditions(rule RuleSet, name string, value any, present bool, siblings map[string]any) (ruleName, message string, failed bool) {
+	cr, ok := rule.(conditionalRules)
+	if !ok {
+		return "", "", false
+	}
+
+	requiredIf, requiredUnless, requiredWith, excludedWith := cr.conditions()
+	isPresent := present && !isZero(value)
+
+	for _, cond := range requiredIf {
+		if condMatches(cond, siblings) && !isPresent {
+			return BaseRuleNameRequiredIf, fmt.Sprintf("%q is required when %q equals %v", name, cond.Field, cond.Eq), true
+		}
+	}
+
+	for _, cond := range requiredUnless {
+		if !condMatches(cond, siblings) && !isPresent {
+			return BaseRuleNameRequiredUnless, fmt.Sprintf("%q is required unless %q equals %v", name, cond.Field, cond.Eq), true
+		}
+	}
+
+	for _, sibling := range requiredWith {
+		if fieldPresent(siblings, sibling) && !isPresent {
+			return BaseRuleNameRequiredWith, fmt.Sprintf("%q is required when %q is present", name, sibling), true
+		}
+	}
+
+	for _, sibling := range excludedWith {
+		if fieldPresent(siblings, sibling) && isPresent {
+			return BaseRuleNameExcludedWith, fmt.Sprintf("%q must not be set when %q is present", name, sibling), true
+		}
+	}
+
+	return "", "", false
+}
+
+func condMatches(cond Cond, siblings map[string]any) bool {
+	sibling, ok := siblings[cond.Field]
+	if !ok {
+		return false
+	}
+	return reflect.DeepEqual(sibling, cond.Eq)
+}
+
+func fieldPresent(siblings map[string]any, field string) bool {
+	value, ok := siblings[field]
+	return ok && !isZero(value)
+}
+
+// isZero reports whether v is nil, a nil pointer/interface, or the zero
+// value of its underlying type - "absent" for the purposes of RequiredIf/
+// RequiredUnless/RequiredWith/ExcludedWith.
+func isZero(v any) bool {
+	if v == nil {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return rv.IsNil()
+	default:
+		return rv.IsZero()
+	}
+}
+
+// toFieldMap normalizes i into a field-name -> value map: a map[string]any
+// is used directly, a struct is read one level deep via its `sval:"..."`
+// tags (the same tag collectSiblings uses), and anything else fails.
+func toFieldMap(i any) (map[string]any, bool) {
+	if m, ok := i.(map[string]any); ok {
+		return m, true
+	}
+
+	val := reflect.ValueOf(i)
+	for val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			return nil, false
+		}
+		val = val.Elem()
+	}
+
+	if val.Kind() != reflect.Struct {
+		return nil, false
+	}
+
+	return collectSiblings(val, val.Type()), true
+}
@@ -0,0 +1,100 @@
+package sval
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchema_RequiredIf(t *testing.T) {
+	schema := Schema{
+		"country": &StringRules{},
+		"state": &StringRules{
+			BaseRules: BaseRules{RequiredIf: []Cond{{Field: "country", Eq: "US"}}},
+		},
+	}
+
+	err := schema.Validate(map[string]any{"country": "US"})
+	assert.Error(t, err)
+
+	assert.NoError(t, schema.Validate(map[string]any{"country": "US", "state": "CA"}))
+	assert.NoError(t, schema.Validate(map[string]any{"country": "FR"}))
+}
+
+func TestSchema_RequiredUnless(t *testing.T) {
+	schema := Schema{
+		"plan": &StringRules{},
+		"card_token": &StringRules{
+			BaseRules: BaseRules{RequiredUnless: []Cond{{Field: "plan", Eq: "free"}}},
+		},
+	}
+
+	assert.Error(t, schema.Validate(map[string]any{"plan": "pro"}))
+	assert.NoError(t, schema.Validate(map[string]any{"plan": "pro", "card_token": "tok_123"}))
+	assert.NoError(t, schema.Validate(map[string]any{"plan": "free"}))
+}
+
+func TestSchema_RequiredWith(t *testing.T) {
+	schema := Schema{
+		"state": &StringRules{},
+		"zip": &StringRules{
+			BaseRules: BaseRules{RequiredWith: []string{"state"}},
+		},
+	}
+
+	assert.Error(t, schema.Validate(map[string]any{"state": "CA"}))
+	assert.NoError(t, schema.Validate(map[string]any{"state": "CA", "zip": "90001"}))
+	assert.NoError(t, schema.Validate(map[string]any{}))
+}
+
+func TestSchema_ExcludedWith(t *testing.T) {
+	schema := Schema{
+		"sso_token": &StringRules{},
+		"password": &StringRules{
+			BaseRules: BaseRules{ExcludedWith: []string{"sso_token"}},
+		},
+	}
+
+	assert.Error(t, schema.Validate(map[string]any{"sso_token": "abc", "password": "hunter2"}))
+	assert.NoError(t, schema.Validate(map[string]any{"sso_token": "abc"}))
+	assert.NoError(t, schema.Validate(map[string]any{"password": "hunter2"}))
+}
+
+func TestSchema_ErrorFieldPath(t *testing.T) {
+	schema := Schema{
+		"country": &StringRules{},
+		"state": &StringRules{
+			BaseRules: BaseRules{RequiredIf: []Cond{{Field: "country", Eq: "US"}}},
+		},
+	}
+
+	err := schema.Validate(map[string]any{"country": "US"})
+	assert.Error(t, err)
+
+	ve, ok := err.(*ValidationError)
+	assert.True(t, ok)
+	assert.Equal(t, "state", ve.First().Field)
+	assert.Equal(t, BaseRuleNameRequiredIf, ve.First().Rule)
+}
+
+func TestSchema_Struct(t *testing.T) {
+	type address struct {
+		State string `sval:"state"`
+		Zip   string `sval:"zip"`
+	}
+
+	schema := Schema{
+		"state": &StringRules{},
+		"zip": &StringRules{
+			BaseRules: BaseRules{RequiredWith: []string{"state"}},
+		},
+	}
+
+	assert.Error(t, schema.Validate(address{State: "CA"}))
+	assert.NoError(t, schema.Validate(address{State: "CA", Zip: "90001"}))
+}
+
+func TestSchema_InvalidInput(t *testing.T) {
+	schema := Schema{"country": &StringRules{}}
+	assert.Error(t, schema.Validate(42))
+}
@@ -1,10 +1,13 @@
 package sval
 
 import (
+	"errors"
+	"fmt"
 	"math"
 	"regexp"
 	"slices"
 	"strings"
+	"sync"
 	"unicode/utf8"
 )
 
@@ -24,6 +27,7 @@ const (
 	StringRuleNameNotContains  StringRuleName = "not_contains"
 	StringRuleNameOneOf        StringRuleName = "one_of"
 	StringRuleNameMinEntropy   StringRuleName = "min_entropy"
+	StringRuleNameConfig       StringRuleName = "config"
 )
 
 type StringRules struct {
@@ -42,7 +46,22 @@ type StringRules struct {
 	NotContains  []string `json:"not_contains,omitempty" yaml:"not_contains,omitempty"`
 	OneOf        []string `json:"one_of,omitempty" yaml:"one_of,omitempty"`
 	MinEntropy   float64  `json:"min_entropy,omitempty" yaml:"min_entropy,omitempty"`
-	// TODO: add compiled regex for performance
+	// CaseInsensitive folds Contains/NotContains/OneOf comparisons to
+	// lowercase instead of matching the raw value.
+	CaseInsensitive bool `json:"case_insensitive" yaml:"case_insensitive"`
+
+	// compileOnce guards compiledRegex/compiledContains/compiledNotContains/
+	// compiledOneOf/compileErr so Regex is compiled, and
+	// OnlyDigits/OnlyLetters/CaseInsensitive are validated and lowered,
+	// exactly once rather than on every Validate call. See IPRules.compile
+	// for why this is a sync.Once and not a plain bool: ValidateContext can
+	// drive the same *StringRules from multiple goroutines.
+	compileOnce         sync.Once
+	compileErr          error
+	compiledRegex       *regexp.Regexp
+	compiledContains    []string
+	compiledNotContains []string
+	compiledOneOf       []string
 }
 
 var (
@@ -51,12 +70,51 @@ var (
 	noWhitespaceRegex = regexp.MustCompile(`^\S+$`)
 )
 
+// Compile validates and precompiles StringRules' configuration: it rejects
+// OnlyDigits and OnlyLetters both being set, compiles Regex into a
+// *regexp.Regexp, and, when CaseInsensitive is set, lowercases
+// Contains/NotContains/OneOf once up front. It is idempotent and safe to
+// call concurrently; NewValidatorFromConfig calls it for every registered
+// rule, and Validate calls it lazily for rules built as struct literals.
+func (r *StringRules) Compile() error {
+	r.compileOnce.Do(func() {
+		if r.OnlyDigits && r.OnlyLetters {
+			r.compileErr = errors.New("only_digits and only_letters cannot both be set")
+			return
+		}
+
+		if r.Regex != nil {
+			re, compileErr := regexp.Compile(*r.Regex)
+			if compileErr != nil {
+				r.compileErr = fmt.Errorf("invalid regex %q: %w", *r.Regex, compileErr)
+				return
+			}
+			r.compiledRegex = re
+		}
+
+		if r.CaseInsensitive {
+			r.compiledContains = lowerAll(r.Contains)
+			r.compiledNotContains = lowerAll(r.NotContains)
+			r.compiledOneOf = lowerAll(r.OneOf)
+		}
+	})
+	return r.compileErr
+}
+
+func lowerAll(values []string) []string {
+	lowered := make([]string, len(values))
+	for i, v := range values {
+		lowered[i] = strings.ToLower(v)
+	}
+	return lowered
+}
+
 func (r *StringRules) Validate(i any) error {
 	err := NewValidationError()
 
 	if i == nil {
 		if r.Required {
-			err.AddError(BaseRuleNameRequired, r.Required, i, FieldIsRequired)
+			err.AddReasonError(BaseRuleNameRequired, ReasonRequired, r.Required, i, FieldIsRequired)
 			return err
 		}
 		return nil
@@ -65,7 +123,7 @@ func (r *StringRules) Validate(i any) error {
 	if ptr, ok := i.(*string); ok {
 		if ptr == nil {
 			if r.Required {
-				err.AddError(BaseRuleNameRequired, r.Required, i, FieldIsRequired)
+				err.AddReasonError(BaseRuleNameRequired, ReasonRequired, r.Required, i, FieldIsRequired)
 				return err
 			}
 			return nil
@@ -75,47 +133,48 @@ func (r *StringRules) Validate(i any) error {
 
 	val, ok := i.(string)
 	if !ok {
-		err.AddError(BaseRuleNameType, TypeString, i, "value must be a string")
+		err.AddReasonError(BaseRuleNameType, ReasonType, TypeString, i, "value must be a string")
 		return err
 	}
 
 	if val == "" {
 		if r.Required {
-			err.AddError(BaseRuleNameRequired, r.Required, i, FieldIsRequired)
+			err.AddReasonError(BaseRuleNameRequired, ReasonRequired, r.Required, i, FieldIsRequired)
 			return err
 		}
 		return nil
 	}
 
+	if compileErr := r.Compile(); compileErr != nil {
+		err.AddReasonError(StringRuleNameConfig, ReasonConfig, nil, i, compileErr.Error())
+		return err
+	}
+
 	length := utf8.RuneCountInString(val)
 	if r.MinLen > 0 && length < r.MinLen {
-		err.AddError(StringRuleNameMinLen, r.MinLen, i, "string too short")
+		err.AddReasonError(StringRuleNameMinLen, ReasonMinLen, r.MinLen, i, "string too short")
 	}
 
 	if r.MaxLen > 0 && length > r.MaxLen {
-		err.AddError(StringRuleNameMaxLen, r.MaxLen, i, "string too long")
+		err.AddReasonError(StringRuleNameMaxLen, ReasonMaxLen, r.MaxLen, i, "string too long")
 	}
 
-	if r.Regex != nil {
-		// TODO: move regex compilation from validate
-		re, compileErr := regexp.Compile(*r.Regex)
-		if compileErr == nil && !re.MatchString(val) {
-			err.AddError(StringRuleNameRegex, *r.Regex, i, "string does not match pattern")
-		}
+	if r.compiledRegex != nil && !r.compiledRegex.MatchString(val) {
+		err.AddReasonError(StringRuleNameRegex, ReasonRegexMismatch, *r.Regex, i, "string does not match pattern")
 	}
 
 	if r.OnlyDigits && !onlyDigitsRegex.MatchString(val) {
-		err.AddError(StringRuleNameOnlyDigits, true, i, "string must contain only digits")
+		err.AddReasonError(StringRuleNameOnlyDigits, ReasonOnlyDigits, true, i, "string must contain only digits")
 	}
 
 	// Only Digits and Only Letters will be checked by CLI,
 	// so if both are true, it will be an error
 	if r.OnlyLetters && !onlyLettersRegex.MatchString(val) {
-		err.AddError(StringRuleNameOnlyLetters, true, i, "string must contain only letters")
+		err.AddReasonError(StringRuleNameOnlyLetters, ReasonOnlyLetters, true, i, "string must contain only letters")
 	}
 
 	if r.NoWhitespace && !noWhitespaceRegex.MatchString(val) {
-		err.AddError(StringRuleNameNoWhitespace, true, i, "string must not contain whitespace")
+		err.AddReasonError(StringRuleNameNoWhitespace, ReasonNoWhitespace, true, i, "string must not contain whitespace")
 	}
 
 	// strange rule, i think must be 1st, but its here xd
@@ -124,18 +183,25 @@ func (r *StringRules) Validate(i any) error {
 	}
 
 	if r.StartsWith != nil && !strings.HasPrefix(val, *r.StartsWith) {
-		err.AddError(StringRuleNameStartsWith, *r.StartsWith, i, "string must start with specified prefix")
+		err.AddReasonError(StringRuleNameStartsWith, ReasonStartsWith, *r.StartsWith, i, "string must start with specified prefix")
 	}
 
 	if r.EndsWith != nil && !strings.HasSuffix(val, *r.EndsWith) {
-		err.AddError(StringRuleNameEndsWith, *r.EndsWith, i, "string must end with specified suffix")
+		err.AddReasonError(StringRuleNameEndsWith, ReasonEndsWith, *r.EndsWith, i, "string must end with specified suffix")
+	}
+
+	compareVal := val
+	contains, notContains, oneOf := r.Contains, r.NotContains, r.OneOf
+	if r.CaseInsensitive {
+		compareVal = strings.ToLower(val)
+		contains, notContains, oneOf = r.compiledContains, r.compiledNotContains, r.compiledOneOf
 	}
 
-	if len(r.Contains) > 0 {
-		for _, substr := range r.Contains {
-			if !strings.Contains(val, substr) {
+	if len(contains) > 0 {
+		for _, substr := range contains {
+			if !strings.Contains(compareVal, substr) {
 				// rule value - substr or full slice?
-				err.AddError(StringRuleNameContains, substr, i, "string must contain specified substrings")
+				err.AddReasonError(StringRuleNameContains, ReasonContains, substr, i, "string must contain specified substrings")
 				break
 			} else {
 				break // if one of them is found, we can break
@@ -143,19 +209,19 @@ func (r *StringRules) Validate(i any) error {
 		}
 	}
 
-	if len(r.NotContains) > 0 {
-		for _, substr := range r.NotContains {
-			if strings.Contains(val, substr) {
+	if len(notContains) > 0 {
+		for _, substr := range notContains {
+			if strings.Contains(compareVal, substr) {
 				// rule value - substr or full slice?
-				err.AddError(StringRuleNameNotContains, substr, i, "string must not contain specified substring")
+				err.AddReasonError(StringRuleNameNotContains, ReasonNotContains, substr, i, "string must not contain specified substring")
 				break
 			}
 		}
 	}
 
-	if len(r.OneOf) > 0 {
-		if !slices.Contains(r.OneOf, val) {
-			err.AddError(StringRuleNameOneOf, r.OneOf, i, "string must be one of the specified values")
+	if len(oneOf) > 0 {
+		if !slices.Contains(oneOf, compareVal) {
+			err.AddReasonError(StringRuleNameOneOf, ReasonOneOf, r.OneOf, i, "string must be one of the specified values")
 		}
 	}
 
@@ -163,7 +229,7 @@ func (r *StringRules) Validate(i any) error {
 	if r.MinEntropy > 0 {
 		entropy := entropy(val)
 		if entropy < r.MinEntropy {
-			err.AddError(StringRuleNameMinEntropy, r.MinEntropy, i, "string entropy is too low")
+			err.AddReasonError(StringRuleNameMinEntropy, ReasonMinEntropy, r.MinEntropy, i, "string entropy is too low")
 		}
 	}
 
@@ -322,9 +322,58 @@ func TestStringRules(t *testing.T) {
 			value:   "test_mid_end",
 			wantErr: false,
 		},
+
+		// Compile-time config validation tests
+		{
+			name: "invalid regex is a config error",
+			rules: StringRules{
+				Regex: stringPtr("("),
+			},
+			value:   "anything",
+			wantErr: true,
+		},
+		{
+			name: "only_digits and only_letters both set is a config error",
+			rules: StringRules{
+				OnlyDigits:  true,
+				OnlyLetters: true,
+			},
+			value:   "123",
+			wantErr: true,
+		},
+
+		// CaseInsensitive tests
+		{
+			name: "case insensitive contains matches different case",
+			rules: StringRules{
+				CaseInsensitive: true,
+				Contains:        []string{"TEST"},
+			},
+			value:   "this is a test",
+			wantErr: false,
+		},
+		{
+			name: "case insensitive one_of matches different case",
+			rules: StringRules{
+				CaseInsensitive: true,
+				OneOf:           []string{"OPTION1", "OPTION2"},
+			},
+			value:   "option1",
+			wantErr: false,
+		},
+		{
+			name: "case insensitive not_contains still rejects different case",
+			rules: StringRules{
+				CaseInsensitive: true,
+				NotContains:     []string{"FOO"},
+			},
+			value:   "has foo in it",
+			wantErr: true,
+		},
 	}
 
-	for _, tt := range tests {
+	for i := range tests {
+		tt := &tests[i]
 		t.Run(tt.name, func(t *testing.T) {
 			err := tt.rules.Validate(tt.value)
 			if tt.wantErr {
@@ -336,6 +385,30 @@ func TestStringRules(t *testing.T) {
 	}
 }
 
+func TestStringRules_Compile(t *testing.T) {
+	r := &StringRules{Regex: stringPtr(`^[a-z]+$`)}
+	assert.NoError(t, r.Compile())
+	assert.NoError(t, r.Compile(), "Compile must be idempotent")
+
+	bad := &StringRules{Regex: stringPtr("(")}
+	err := bad.Compile()
+	assert.Error(t, err)
+	assert.Equal(t, err, bad.Compile(), "Compile must cache and return the same error")
+
+	conflict := &StringRules{OnlyDigits: true, OnlyLetters: true}
+	assert.Error(t, conflict.Compile())
+}
+
+func TestStringRules_ReasonCodes(t *testing.T) {
+	rules := StringRules{MinLen: 10}
+	err := rules.Validate("short")
+	assert.Error(t, err)
+
+	ve, ok := err.(*ValidationError)
+	assert.True(t, ok)
+	assert.Equal(t, ReasonMinLen, ve.First().Reason)
+}
+
 func stringPtr(s string) *string {
 	return &s
 }
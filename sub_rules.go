@@ -0,0 +1,47 @@
+package sval
+
+import "fmt"
+
+// subRuleResolver builds the RuleSet for a named entry in
+// ValidatorConfig.SubRules on first reference and caches it, so a sub-rule
+// referenced by several fields is only compiled once. It also guards against
+// a sub-rule (transitively) referencing itself, which would otherwise
+// recurse forever.
+type subRuleResolver struct {
+	defs      map[string]RuleConfig
+	resolved  map[string]RuleSet
+	resolving map[string]bool
+}
+
+func newSubRuleResolver(defs map[string]RuleConfig) *subRuleResolver {
+	return &subRuleResolver{
+		defs:      defs,
+		resolved:  make(map[string]RuleSet),
+		resolving: make(map[string]bool),
+	}
+}
+
+func (s *subRuleResolver) resolve(name string) (RuleSet, error) {
+	if rs, ok := s.resolved[name]; ok {
+		return rs, nil
+	}
+
+	if s.resolving[name] {
+		return nil, fmt.Errorf("sub-rule %q: cycle detected", name)
+	}
+
+	cfg, ok := s.defs[name]
+	if !ok {
+		return nil, fmt.Errorf("sub-rule %q is not defined", name)
+	}
+
+	s.resolving[name] = true
+	rs, err := createRuleSetWithSubRules(cfg, s)
+	delete(s.resolving, name)
+	if err != nil {
+		return nil, fmt.Errorf("sub-rule %q: %w", name, err)
+	}
+
+	s.resolved[name] = rs
+	return rs, nil
+}
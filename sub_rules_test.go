@@ -0,0 +1,132 @@
+package sval
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubRules_RefResolved(t *testing.T) {
+	v, err := NewValidatorFromConfig(ValidatorConfig{
+		SubRules: map[string]RuleConfig{
+			"corporate_email": {
+				AllOf: []RuleConfig{
+					{Type: "email", Params: map[string]any{"strategy": "rfc5322"}},
+					{Type: "email", Params: map[string]any{"allowed_domains": []any{"acme.org"}}},
+				},
+			},
+		},
+		Rules: map[string]RuleConfig{
+			"email": {Ref: ptr("corporate_email")},
+		},
+	})
+	assert.NoError(t, err)
+
+	type TestStruct struct {
+		Email string `sval:"email"`
+	}
+
+	assert.NoError(t, v.Validate(TestStruct{Email: "user@acme.org"}))
+	assert.Error(t, v.Validate(TestStruct{Email: "user@example.com"}))
+}
+
+func TestSubRules_SharedAcrossFields(t *testing.T) {
+	v, err := NewValidatorFromConfig(ValidatorConfig{
+		SubRules: map[string]RuleConfig{
+			"short_code": {Type: "string", Params: map[string]any{"max_len": 4}},
+		},
+		Rules: map[string]RuleConfig{
+			"a": {Ref: ptr("short_code")},
+			"b": {Ref: ptr("short_code")},
+		},
+	})
+	assert.NoError(t, err)
+
+	type TestStruct struct {
+		A string `sval:"a"`
+		B string `sval:"b"`
+	}
+
+	assert.NoError(t, v.Validate(TestStruct{A: "ab", B: "cd"}))
+	assert.Error(t, v.Validate(TestStruct{A: "toolong", B: "cd"}))
+}
+
+func TestSubRules_UndefinedRef(t *testing.T) {
+	_, err := NewValidatorFromConfig(ValidatorConfig{
+		Rules: map[string]RuleConfig{
+			"email": {Ref: ptr("missing")},
+		},
+	})
+	assert.Error(t, err)
+}
+
+func TestSubRules_RefWithoutSubRulesContext(t *testing.T) {
+	_, err := createRuleSet(RuleConfig{Ref: ptr("anything")})
+	assert.Error(t, err)
+}
+
+func TestSubRules_CycleDetected(t *testing.T) {
+	_, err := NewValidatorFromConfig(ValidatorConfig{
+		SubRules: map[string]RuleConfig{
+			"a": {Ref: ptr("b")},
+			"b": {Ref: ptr("a")},
+		},
+		Rules: map[string]RuleConfig{
+			"field": {Ref: ptr("a")},
+		},
+	})
+	assert.Error(t, err)
+}
+
+func TestSubRules_RefWithWhen(t *testing.T) {
+	v, err := NewValidatorFromConfig(ValidatorConfig{
+		SubRules: map[string]RuleConfig{
+			"corporate_email": {Type: "email", Params: map[string]any{"allowed_domains": []any{"acme.org"}}},
+		},
+		Rules: map[string]RuleConfig{
+			"email": {
+				Ref:  ptr("corporate_email"),
+				When: &Condition{Field: "kind", Equals: "business"},
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	type TestStruct struct {
+		Kind  string `sval:"kind"`
+		Email string `sval:"email"`
+	}
+
+	// kind != "business": the corporate_email sub-rule is gated off, so a
+	// non-corporate address still passes.
+	assert.NoError(t, v.Validate(TestStruct{Kind: "personal", Email: "user@example.com"}))
+
+	// kind == "business": the sub-rule applies.
+	assert.NoError(t, v.Validate(TestStruct{Kind: "business", Email: "user@acme.org"}))
+	assert.Error(t, v.Validate(TestStruct{Kind: "business", Email: "user@example.com"}))
+}
+
+func TestSubRules_NestedInsideCombinator(t *testing.T) {
+	v, err := NewValidatorFromConfig(ValidatorConfig{
+		SubRules: map[string]RuleConfig{
+			"min3": {Type: "string", Params: map[string]any{"min_len": 3}},
+		},
+		Rules: map[string]RuleConfig{
+			"name": {
+				AnyOf: []RuleConfig{
+					{Ref: ptr("min3")},
+					{Type: "string", Params: map[string]any{"one_of": []any{"ok"}}},
+				},
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	type TestStruct struct {
+		Name string `sval:"name"`
+	}
+
+	assert.NoError(t, v.Validate(TestStruct{Name: "ok"}))
+	assert.NoError(t, v.Validate(TestStruct{Name: "abcd"}))
+	assert.Error(t, v.Validate(TestStruct{Name: "a"}))
+}
@@ -1,12 +1,14 @@
 package sval
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
 	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -20,12 +22,55 @@ const (
 var indexRegex = regexp.MustCompile(`\[\d+\]`)
 
 type validator struct {
-	rules map[string]RuleSet
+	rules          map[string]RuleSet
+	translator     Translator
+	locale         string
+	maxParallelism int
+	// events is created lazily by Subscribe, so a validator with no
+	// subscribers never pays for the worker pool or the lock.
+	events *eventBus
+	// crossFieldRules holds every rule added via AddCrossFieldRule. See
+	// cross_field_expr.go.
+	crossFieldRules []crossFieldRuleEntry
+}
+
+// Option configures a validator at construction time.
+type Option func(*validator)
+
+// WithTranslator sets the Translator used to localize ValidationError
+// messages. Without one, rules keep returning their hard-coded English
+// messages.
+func WithTranslator(t Translator) Option {
+	return func(v *validator) {
+		v.translator = t
+	}
+}
+
+// WithLocale sets the locale passed to the Translator. Defaults to "en".
+func WithLocale(locale string) Option {
+	return func(v *validator) {
+		v.locale = locale
+	}
+}
+
+// WithMaxParallelism sets the size of the worker pool ValidateContext
+// dispatches field validations onto. Defaults to defaultMaxParallelism.
+func WithMaxParallelism(n int) Option {
+	return func(v *validator) {
+		if n > 0 {
+			v.maxParallelism = n
+		}
+	}
 }
 
 type ValidatorConfig struct {
 	Version int                   `yaml:"version" json:"version"`
 	Rules   map[string]RuleConfig `yaml:"rules" json:"rules"`
+	// SubRules declares named, reusable RuleConfigs that fields (or other
+	// sub-rules) can pull in via RuleConfig.Ref, instead of repeating the
+	// same any_of/all_of tree under every field that needs it. See
+	// sub_rules.go.
+	SubRules map[string]RuleConfig `yaml:"sub_rules,omitempty" json:"sub_rules,omitempty"`
 }
 
 type ConfigLoader interface {
@@ -42,48 +87,82 @@ func (l *FileConfigLoader) Load() (ValidatorConfig, error) {
 		return ValidatorConfig{}, err
 	}
 
+	return decodeValidatorConfig(l.Path, data)
+}
+
+// decodeValidatorConfig unmarshals data as YAML or JSON based on path's
+// extension, then expands any ${ENV_VAR} references in string params (see
+// multi_config_loader.go). FileConfigLoader and EmbeddedConfigLoader share
+// this so both formats behave identically regardless of where the bytes
+// came from.
+func decodeValidatorConfig(path string, data []byte) (ValidatorConfig, error) {
 	var config ValidatorConfig
+	var err error
 
 	switch {
-	case strings.HasSuffix(l.Path, ".yaml"), strings.HasSuffix(l.Path, ".yml"):
+	case strings.HasSuffix(path, ".yaml"), strings.HasSuffix(path, ".yml"):
 		err = yaml.Unmarshal(data, &config)
-	case strings.HasSuffix(l.Path, ".json"):
+	case strings.HasSuffix(path, ".json"):
 		err = json.Unmarshal(data, &config)
 	default:
 		return ValidatorConfig{}, errors.New("unsupported config format")
 	}
+	if err != nil {
+		return ValidatorConfig{}, err
+	}
 
-	return config, err
+	return expandEnvInConfig(config), nil
 }
 
+// DefaultConfigLoader looks for sval.yaml/sval.yml/sval.json in the working
+// directory and layers an EnvConfigLoader on top of whichever one it finds
+// (or on its own, if none exist), so SVAL_RULES_* env vars always take
+// effect without a caller having to opt in via MultiConfigLoader themselves.
 func DefaultConfigLoader() ConfigLoader {
 	paths := []string{
 		"sval.yaml",
 		"sval.yml",
 		"sval.json",
 	}
+
+	loaders := make([]ConfigLoader, 0, 2)
 	for _, p := range paths {
 		if _, err := os.Stat(p); err == nil {
-			return &FileConfigLoader{Path: p}
+			loaders = append(loaders, &FileConfigLoader{Path: p})
+			break
 		}
 	}
-	return nil
+	loaders = append(loaders, &EnvConfigLoader{})
+
+	return NewMultiConfigLoader(loaders...)
 }
 
-func NewWithConfig(loader ConfigLoader) (*validator, error) {
+func NewWithConfig(loader ConfigLoader, opts ...Option) (*validator, error) {
 	config, err := loader.Load()
 	if err != nil {
 		return nil, err
 	}
-	return NewValidatorFromConfig(config)
+	return NewValidatorFromConfig(config, opts...)
 }
 
-func New() (*validator, error) {
+// New builds a validator from DefaultConfigLoader, which now always
+// resolves to at least an EnvConfigLoader - see DefaultConfigLoader. Unlike
+// NewWithConfig, New errors if that resolves zero rules, since for the
+// zero-argument constructor that almost always means a missing/misnamed
+// sval.yaml rather than an intentionally empty config - and a validator with
+// no rules silently accepts everything.
+func New(opts ...Option) (*validator, error) {
 	loader := DefaultConfigLoader()
-	if loader == nil {
-		return nil, errors.New("no config file found")
+
+	config, err := loader.Load()
+	if err != nil {
+		return nil, err
 	}
-	return NewWithConfig(loader)
+	if len(config.Rules) == 0 && len(config.SubRules) == 0 {
+		return nil, errors.New("no config file found and no SVAL_RULES_* environment variables set")
+	}
+
+	return NewValidatorFromConfig(config, opts...)
 }
 
 type RuleSet interface {
@@ -100,27 +179,116 @@ func (v *validator) AddRule(fieldName string, rules RuleSet) {
 type RuleType string
 
 const (
-	TypeString   RuleType = "string"
-	TypeEmail    RuleType = "email"
-	TypePassword RuleType = "password"
-	TypeInt      RuleType = "int"
-	TypeFloat    RuleType = "float"
-	TypeIP       RuleType = "ip"
-	TypeMAC      RuleType = "mac"
+	TypeString     RuleType = "string"
+	TypeEmail      RuleType = "email"
+	TypePassword   RuleType = "password"
+	TypeInt        RuleType = "int"
+	TypeFloat      RuleType = "float"
+	TypeIP         RuleType = "ip"
+	TypeCIDR       RuleType = "cidr"
+	TypeURL        RuleType = "url"
+	TypeDNS        RuleType = "dns"
+	TypeURI        RuleType = "uri"
+	TypeMAC        RuleType = "mac"
+	TypeTime       RuleType = "time"
+	TypeCrossField RuleType = "cross_field"
+	TypePostcode   RuleType = "postcode"
 )
 
 type RuleConfig struct {
 	Type   string         `json:"type" yaml:"type"`
 	Params map[string]any `json:"params" yaml:"params"`
+	// When makes this rule conditional on a sibling field's value, e.g.
+	// {Field: "type", Equals: "business"}. Evaluated relative to the struct
+	// or slice element the field belongs to.
+	When *Condition `json:"when,omitempty" yaml:"when,omitempty"`
+	// AnyOf/AllOf/OneOf/Not compose other rule configs instead of declaring a
+	// Type directly. At most one of these should be set; when one is, Type
+	// and Params are ignored. See composite_rules.go.
+	AnyOf []RuleConfig `json:"any_of,omitempty" yaml:"any_of,omitempty"`
+	AllOf []RuleConfig `json:"all_of,omitempty" yaml:"all_of,omitempty"`
+	OneOf []RuleConfig `json:"one_of,omitempty" yaml:"one_of,omitempty"`
+	Not   *RuleConfig  `json:"not,omitempty" yaml:"not,omitempty"`
+	// Ref inlines the named RuleConfig from ValidatorConfig.SubRules instead
+	// of declaring Type/Params/AnyOf/... directly. Takes priority over
+	// Type/Params/AnyOf/AllOf/OneOf/Not when set; When still gates the
+	// resolved sub-rule. See sub_rules.go.
+	Ref *string `json:"ref,omitempty" yaml:"ref,omitempty"`
 }
 
-func NewValidatorFromConfig(config ValidatorConfig) (*validator, error) {
+// Condition is a simple sibling-field predicate used by RuleConfig.When.
+type Condition struct {
+	Field  string `json:"field" yaml:"field"`
+	Equals any    `json:"equals" yaml:"equals"`
+}
+
+// ContextualRuleSet is an optional extension of RuleSet for rules that need
+// to read sibling field values from the enclosing struct/slice element, such
+// as cross-field comparisons or a RuleConfig.When predicate. validateRecursive
+// prefers it over Validate whenever sibling values are available.
+type ContextualRuleSet interface {
+	RuleSet
+	ValidateWithSiblings(i any, siblings map[string]any) error
+}
+
+// Compiler is an optional RuleSet extension for rule types with a fallible
+// or expensive one-time setup step - compiling a regex, parsing CIDR
+// ranges, validating that two fields aren't both set - that shouldn't
+// re-run on every Validate call. NewValidatorFromConfig calls Compile (via
+// compileRuleSet) on every registered rule right after loading, so a bad
+// regex or an unparsable subnet fails as a ConfigError at load time instead
+// of surfacing from the first Validate call.
+type Compiler interface {
+	Compile() error
+}
+
+// ConfigError aggregates every field whose rule failed Compile when a
+// ValidatorConfig was loaded, so NewValidatorFromConfig reports all
+// misconfigured rules at once instead of just the first.
+type ConfigError struct {
+	Fields map[string]error
+}
+
+func (e *ConfigError) add(field string, err error) {
+	if e.Fields == nil {
+		e.Fields = make(map[string]error)
+	}
+	e.Fields[field] = err
+}
+
+func (e *ConfigError) hasErrors() bool {
+	return len(e.Fields) > 0
+}
+
+func (e *ConfigError) Error() string {
+	fields := make([]string, 0, len(e.Fields))
+	for field := range e.Fields {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	parts := make([]string, 0, len(fields))
+	for _, field := range fields {
+		parts = append(parts, fmt.Sprintf("%s: %s", field, e.Fields[field]))
+	}
+	return "invalid rule configuration: " + strings.Join(parts, "; ")
+}
+
+func NewValidatorFromConfig(config ValidatorConfig, opts ...Option) (*validator, error) {
 	v := &validator{
-		rules: make(map[string]RuleSet),
+		rules:          make(map[string]RuleSet),
+		locale:         "en",
+		maxParallelism: defaultMaxParallelism,
+	}
+
+	for _, opt := range opts {
+		opt(v)
 	}
 
+	sub := newSubRuleResolver(config.SubRules)
+
 	for field, ruleCfg := range config.Rules {
-		ruleSet, err := createRuleSet(ruleCfg)
+		ruleSet, err := createRuleSetWithSubRules(ruleCfg, sub)
 		if err != nil {
 			return nil, fmt.Errorf("field %s: %w", field, err)
 		}
@@ -128,28 +296,167 @@ func NewValidatorFromConfig(config ValidatorConfig) (*validator, error) {
 		v.AddRule(field, ruleSet)
 	}
 
+	cfgErr := &ConfigError{}
+	for field, ruleSet := range v.rules {
+		if err := compileRuleSet(ruleSet); err != nil {
+			cfgErr.add(field, err)
+		}
+	}
+	if cfgErr.hasErrors() {
+		return nil, cfgErr
+	}
+
 	return v, nil
 }
 
+// compileRuleSet runs Compile on rs when it implements Compiler, unwrapping
+// the AnyOf/AllOf/OneOf/Not/When combinators first so their nested rules
+// get compiled too. Mirrors schemaForRuleSet's unwrapping in json_schema.go.
+func compileRuleSet(rs RuleSet) error {
+	switch r := rs.(type) {
+	case *conditionalRuleSet:
+		return compileRuleSet(r.inner)
+	case *allOfRuleSet:
+		return compileRuleSets(r.rules)
+	case *anyOfRuleSet:
+		return compileRuleSets(r.rules)
+	case *oneOfRuleSet:
+		return compileRuleSets(r.rules)
+	case *notRuleSet:
+		return compileRuleSet(r.rule)
+	}
+
+	if c, ok := rs.(Compiler); ok {
+		return c.Compile()
+	}
+	return nil
+}
+
+func compileRuleSets(rules []RuleSet) error {
+	for _, rs := range rules {
+		if err := compileRuleSet(rs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func createRuleSet(cfg RuleConfig) (RuleSet, error) {
-	switch strings.ToLower(cfg.Type) {
-	case string(TypeString):
-		return parseStringRules(cfg.Params)
-	case string(TypeEmail):
-		return parseEmailRules(cfg.Params)
-	case string(TypePassword):
-		return parsePasswordRules(cfg.Params)
-	case string(TypeInt):
-		return parseIntRules(cfg.Params)
-	case string(TypeFloat):
-		return parseFloatRules(cfg.Params)
-	case string(TypeIP):
-		return parseIPRules(cfg.Params)
-	case string(TypeMAC):
-		return parseMACRules(cfg.Params)
+	return createRuleSetWithSubRules(cfg, nil)
+}
+
+// createRuleSetWithSubRules is createRuleSet plus a subRuleResolver so
+// RuleConfig.Ref can be resolved against ValidatorConfig.SubRules. sub may
+// be nil, in which case a Ref config fails with a descriptive error instead
+// of a nil pointer dereference.
+func createRuleSetWithSubRules(cfg RuleConfig, sub *subRuleResolver) (RuleSet, error) {
+	var ruleSet RuleSet
+	var err error
+
+	if cfg.Ref != nil {
+		if sub == nil {
+			return nil, fmt.Errorf("rule references sub-rule %q but no sub_rules are configured", *cfg.Ref)
+		}
+		ruleSet, err = sub.resolve(*cfg.Ref)
+	} else {
+		ruleSet, err = createComposedOrBaseRuleSet(cfg, sub)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.When != nil {
+		return &conditionalRuleSet{inner: ruleSet, when: cfg.When}, nil
+	}
+
+	return ruleSet, nil
+}
+
+// createComposedOrBaseRuleSet dispatches to the AnyOf/AllOf/OneOf/Not
+// combinators when the config declares one, falling back to the plain
+// Type-driven createBaseRuleSet otherwise.
+func createComposedOrBaseRuleSet(cfg RuleConfig, sub *subRuleResolver) (RuleSet, error) {
+	switch {
+	case len(cfg.AnyOf) > 0:
+		rules, err := createRuleSets(cfg.AnyOf, sub)
+		if err != nil {
+			return nil, err
+		}
+		return &anyOfRuleSet{rules: rules}, nil
+	case len(cfg.AllOf) > 0:
+		rules, err := createRuleSets(cfg.AllOf, sub)
+		if err != nil {
+			return nil, err
+		}
+		return &allOfRuleSet{rules: rules}, nil
+	case len(cfg.OneOf) > 0:
+		rules, err := createRuleSets(cfg.OneOf, sub)
+		if err != nil {
+			return nil, err
+		}
+		return &oneOfRuleSet{rules: rules}, nil
+	case cfg.Not != nil:
+		inner, err := createRuleSetWithSubRules(*cfg.Not, sub)
+		if err != nil {
+			return nil, err
+		}
+		return &notRuleSet{rule: inner}, nil
 	default:
+		return createBaseRuleSet(cfg)
+	}
+}
+
+// createRuleSets builds a RuleSet for each config, failing on the first error.
+func createRuleSets(cfgs []RuleConfig, sub *subRuleResolver) ([]RuleSet, error) {
+	rules := make([]RuleSet, 0, len(cfgs))
+	for _, c := range cfgs {
+		rs, err := createRuleSetWithSubRules(c, sub)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rs)
+	}
+	return rules, nil
+}
+
+// createBaseRuleSet looks up cfg.Type in the rule type registry (see
+// rule_registry.go) and runs its parser against cfg.Params. Built-ins are
+// pre-registered at init time, so this resolves exactly like the old
+// hardcoded switch for existing configs, while also picking up any type a
+// caller added via RegisterRuleType.
+func createBaseRuleSet(cfg RuleConfig) (RuleSet, error) {
+	parser, ok := lookupRuleTypeParser(strings.ToLower(cfg.Type))
+	if !ok {
 		return nil, fmt.Errorf("unknown rule type: %s", cfg.Type)
 	}
+	return parser(cfg.Params)
+}
+
+// conditionalRuleSet gates an inner RuleSet behind a RuleConfig.When
+// predicate, resolved against sibling field values at validation time. With
+// no sibling context (e.g. the rule is attached directly to a non-struct
+// root) the predicate can't be evaluated, so the inner rule just runs.
+type conditionalRuleSet struct {
+	inner RuleSet
+	when  *Condition
+}
+
+func (c *conditionalRuleSet) Validate(i any) error {
+	return c.inner.Validate(i)
+}
+
+func (c *conditionalRuleSet) ValidateWithSiblings(i any, siblings map[string]any) error {
+	if c.when != nil {
+		sibling, ok := siblings[c.when.Field]
+		if !ok || !reflect.DeepEqual(sibling, c.when.Equals) {
+			return nil
+		}
+	}
+
+	if inner, ok := c.inner.(ContextualRuleSet); ok {
+		return inner.ValidateWithSiblings(i, siblings)
+	}
+	return c.inner.Validate(i)
 }
 
 func toInt(val any) (int, bool) {
@@ -377,6 +684,15 @@ func parseEmailRules(params map[string]any) (*EmailRules, error) {
 		}
 	}
 
+	if v, ok := params[EmailRuleNameMode]; ok {
+		if mode, ok := v.(string); ok {
+			if _, ok := modeStrategy[mode]; !ok {
+				return nil, fmt.Errorf("invalid email mode: %s", mode)
+			}
+			rules.Mode = mode
+		}
+	}
+
 	if v, ok := params[EmailRuleNameMinDomainLen]; ok {
 		if minLen, ok := toInt(v); ok {
 			rules.MinDomainLen = minLen
@@ -399,6 +715,38 @@ func parseEmailRules(params map[string]any) (*EmailRules, error) {
 		rules.AllowedDomains = domains
 	}
 
+	if v, ok := params[EmailRuleNameBlockedDomains]; ok {
+		domains, err := ConvertToStringArray(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid blocked domains: %w", err)
+		}
+		rules.BlockedDomains = domains
+	}
+
+	if v, ok := params[EmailRuleNameRequireTLD]; ok {
+		if requireTLD, ok := v.(bool); ok {
+			rules.RequireTLD = requireTLD
+		}
+	}
+
+	if v, ok := params[EmailRuleNameMaxLocalLen]; ok {
+		if maxLen, ok := toInt(v); ok {
+			rules.MaxLocalLen = maxLen
+		}
+	}
+
+	if v, ok := params[EmailRuleNameMaxTotalLen]; ok {
+		if maxLen, ok := toInt(v); ok {
+			rules.MaxTotalLen = maxLen
+		}
+	}
+
+	if v, ok := params[EmailRuleNameCheckMX]; ok {
+		if checkMX, ok := v.(bool); ok {
+			rules.CheckMX = checkMX
+		}
+	}
+
 	if v, ok := params[EmailRuleNameRegexp]; ok {
 		// global regex for email validation
 		if regex, ok := v.(*string); ok {
@@ -412,6 +760,22 @@ func parseEmailRules(params map[string]any) (*EmailRules, error) {
 		}
 	}
 
+	if v, ok := params[EmailRuleNamePermittedAddrs]; ok {
+		addrs, err := ConvertToStringArray(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid permitted addresses: %w", err)
+		}
+		rules.PermittedAddresses = addrs
+	}
+
+	if v, ok := params[EmailRuleNameExcludedAddrs]; ok {
+		addrs, err := ConvertToStringArray(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid excluded addresses: %w", err)
+		}
+		rules.ExcludedAddresses = addrs
+	}
+
 	return rules, nil
 }
 
@@ -449,9 +813,9 @@ func parsePasswordRules(params map[string]any) (*PasswordRules, error) {
 		}
 	}
 
-	if v, ok := params[PasswordRuleNameMinDigits]; ok {
+	if v, ok := params[PasswordRuleNameMinNumbers]; ok {
 		if minNumbers, ok := v.(int); ok {
-			rules.MinDigits = minNumbers
+			rules.MinNumbers = minNumbers
 		}
 	}
 
@@ -497,6 +861,12 @@ func parsePasswordRules(params map[string]any) (*PasswordRules, error) {
 		}
 	}
 
+	if v, ok := params["min_linear_run_len"]; ok {
+		if minLinearRunLen, ok := toInt(v); ok {
+			rules.MinLinearRunLen = minLinearRunLen
+		}
+	}
+
 	if v, ok := params[PasswordRuleNameBlacklist]; ok {
 		blacklist, err := ConvertToStringArray(v)
 		if err != nil {
@@ -511,6 +881,12 @@ func parsePasswordRules(params map[string]any) (*PasswordRules, error) {
 		}
 	}
 
+	if v, ok := params[PasswordRuleNameMinGuessesLog10]; ok {
+		if minGuesses, ok := v.(float64); ok {
+			rules.MinGuessesLog10 = &minGuesses
+		}
+	}
+
 	return rules, nil
 }
 
@@ -586,6 +962,38 @@ func parseIPRules(params map[string]any) (RuleSet, error) {
 		}
 	}
 
+	if v, ok := params[IPRuleNameAllowLoopback]; ok {
+		if loopback, ok := v.(bool); ok {
+			rules.AllowLoopback = &loopback
+		} else if loopback, ok := v.(*bool); ok {
+			rules.AllowLoopback = loopback
+		}
+	}
+
+	if v, ok := params[IPRuleNameAllowLinkLocal]; ok {
+		if linkLocal, ok := v.(bool); ok {
+			rules.AllowLinkLocal = &linkLocal
+		} else if linkLocal, ok := v.(*bool); ok {
+			rules.AllowLinkLocal = linkLocal
+		}
+	}
+
+	if v, ok := params[IPRuleNameAllowMulticast]; ok {
+		if multicast, ok := v.(bool); ok {
+			rules.AllowMulticast = &multicast
+		} else if multicast, ok := v.(*bool); ok {
+			rules.AllowMulticast = multicast
+		}
+	}
+
+	if v, ok := params[IPRuleNameAllowUnspecified]; ok {
+		if unspecified, ok := v.(bool); ok {
+			rules.AllowUnspecified = &unspecified
+		} else if unspecified, ok := v.(*bool); ok {
+			rules.AllowUnspecified = unspecified
+		}
+	}
+
 	if v, ok := params[IPRuleNameAllowedSubnets]; ok {
 		subnets, err := ConvertToStringArray(v)
 		if err != nil {
@@ -605,12 +1013,423 @@ func parseIPRules(params map[string]any) (RuleSet, error) {
 	return rules, nil
 }
 
+func parseCIDRRules(params map[string]any) (RuleSet, error) {
+	rules := &CIDRRules{}
+
+	if v, ok := params[BaseRuleNameRequired]; ok {
+		if required, ok := v.(bool); ok {
+			rules.Required = required
+		}
+	}
+
+	if v, ok := params[CIDRRuleNameMinPrefixLen]; ok {
+		if minLen, ok := toInt(v); ok {
+			rules.MinPrefixLen = &minLen
+		}
+	}
+
+	if v, ok := params[CIDRRuleNameMaxPrefixLen]; ok {
+		if maxLen, ok := toInt(v); ok {
+			rules.MaxPrefixLen = &maxLen
+		}
+	}
+
+	if v, ok := params[CIDRRuleNameRequireCanon]; ok {
+		if canon, ok := v.(bool); ok {
+			rules.RequireCanonical = canon
+		}
+	}
+
+	if v, ok := params[CIDRRuleNameRequireHostZero]; ok {
+		if hostZero, ok := v.(bool); ok {
+			rules.RequireHostBitsZero = hostZero
+		}
+	}
+
+	return rules, nil
+}
+
+func parseURLRules(params map[string]any) (RuleSet, error) {
+	rules := &URLRules{}
+
+	if v, ok := params[BaseRuleNameRequired]; ok {
+		if required, ok := v.(bool); ok {
+			rules.Required = required
+		}
+	}
+
+	if v, ok := params[URLRuleNameAllowedSchemes]; ok {
+		schemes, err := ConvertToStringArray(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid allowed schemes: %w", err)
+		}
+		rules.AllowedSchemes = schemes
+	}
+
+	if v, ok := params[URLRuleNameRequireHost]; ok {
+		if requireHost, ok := v.(bool); ok {
+			rules.RequireHost = requireHost
+		}
+	}
+
+	if v, ok := params[URLRuleNameAllowedTLDs]; ok {
+		tlds, err := ConvertToStringArray(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid allowed tlds: %w", err)
+		}
+		rules.AllowedTLDs = tlds
+	}
+
+	if v, ok := params[URLRuleNameDisallowUserinfo]; ok {
+		if disallow, ok := v.(bool); ok {
+			rules.DisallowUserinfo = disallow
+		}
+	}
+
+	if v, ok := params[URLRuleNameMaxLen]; ok {
+		if maxLen, ok := toInt(v); ok {
+			rules.MaxLen = maxLen
+		}
+	}
+
+	if v, ok := params[URLRuleNameResolveDNS]; ok {
+		if resolveDNS, ok := v.(bool); ok {
+			rules.ResolveDNS = resolveDNS
+		}
+	}
+
+	return rules, nil
+}
+
+func parseDNSRules(params map[string]any) (RuleSet, error) {
+	rules := &DNSRules{}
+
+	if v, ok := params[BaseRuleNameRequired]; ok {
+		if required, ok := v.(bool); ok {
+			rules.Required = required
+		}
+	}
+
+	if v, ok := params[DNSRuleNamePermittedDomains]; ok {
+		domains, err := ConvertToStringArray(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid permitted domains: %w", err)
+		}
+		rules.PermittedDomains = domains
+	}
+
+	if v, ok := params[DNSRuleNameExcludedDomains]; ok {
+		domains, err := ConvertToStringArray(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid excluded domains: %w", err)
+		}
+		rules.ExcludedDomains = domains
+	}
+
+	return rules, nil
+}
+
+func parseURIRules(params map[string]any) (RuleSet, error) {
+	rules := &URIRules{}
+
+	if v, ok := params[BaseRuleNameRequired]; ok {
+		if required, ok := v.(bool); ok {
+			rules.Required = required
+		}
+	}
+
+	if v, ok := params[URIRuleNamePermittedDomains]; ok {
+		domains, err := ConvertToStringArray(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid permitted domains: %w", err)
+		}
+		rules.PermittedDomains = domains
+	}
+
+	if v, ok := params[URIRuleNameExcludedDomains]; ok {
+		domains, err := ConvertToStringArray(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid excluded domains: %w", err)
+		}
+		rules.ExcludedDomains = domains
+	}
+
+	return rules, nil
+}
+
+// TODO: add validating parsed rules
+func parseTimeRules(params map[string]any) (*TimeRules, error) {
+	rules := &TimeRules{}
+
+	if v, ok := params[BaseRuleNameRequired]; ok {
+		if required, ok := v.(bool); ok {
+			rules.Required = required
+		}
+	}
+
+	if v, ok := params[TimeRuleNameFormats]; ok {
+		formats, err := ConvertToStringArray(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid formats values: %w", err)
+		}
+		rules.Formats = formats
+	}
+
+	if v, ok := params[TimeRuleNameTimezones]; ok {
+		timezones, err := ConvertToStringArray(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timezones values: %w", err)
+		}
+		rules.Timezones = timezones
+	}
+
+	if v, ok := params[TimeRuleNameBeforeNow]; ok {
+		if beforeNow, ok := v.(bool); ok {
+			rules.BeforeNow = beforeNow
+		}
+	}
+
+	if v, ok := params[TimeRuleNameAfterNow]; ok {
+		if afterNow, ok := v.(bool); ok {
+			rules.AfterNow = afterNow
+		}
+	}
+
+	if v, ok := params[TimeRuleNameWorkday]; ok {
+		if workday, ok := v.(bool); ok {
+			rules.Workday = workday
+		}
+	}
+
+	if v, ok := params[TimeRuleNameSchedule]; ok {
+		schedule, err := parseScheduleParams(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule: %w", err)
+		}
+		rules.Schedule = schedule
+	}
+
+	return rules, nil
+}
+
+// parseScheduleParams reads the "schedule" param block (mon/tue/.../timezone
+// keys, same shape as Schedule's own wire format) into a compiled Schedule.
+func parseScheduleParams(raw any) (*Schedule, error) {
+	m, ok := raw.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("schedule must be an object")
+	}
+
+	wire := scheduleWire{}
+	dayFields := map[string]*string{
+		"sun": &wire.Sun, "mon": &wire.Mon, "tue": &wire.Tue, "wed": &wire.Wed,
+		"thu": &wire.Thu, "fri": &wire.Fri, "sat": &wire.Sat,
+	}
+	for key, dst := range dayFields {
+		if v, ok := m[key]; ok {
+			if s, ok := v.(string); ok {
+				*dst = s
+			}
+		}
+	}
+	if v, ok := m["timezone"]; ok {
+		if s, ok := v.(string); ok {
+			wire.Timezone = s
+		}
+	}
+	if v, ok := m["always_on"]; ok {
+		days, err := ConvertToStringArray(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid always_on values: %w", err)
+		}
+		wire.AlwaysOn = days
+	}
+	if v, ok := m["always_off"]; ok {
+		days, err := ConvertToStringArray(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid always_off values: %w", err)
+		}
+		wire.AlwaysOff = days
+	}
+
+	schedule := &Schedule{}
+	if err := schedule.fromWire(wire); err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+func parsePostcodeRules(params map[string]any) (RuleSet, error) {
+	rules := &PostcodeRules{}
+
+	if v, ok := params[BaseRuleNameRequired]; ok {
+		if required, ok := v.(bool); ok {
+			rules.Required = required
+		}
+	}
+
+	if v, ok := params[PostcodeRuleNameCountry]; ok {
+		if country, ok := v.(string); ok {
+			rules.Country = country
+		}
+	}
+
+	if v, ok := params[PostcodeRuleNameCountryField]; ok {
+		if countryField, ok := v.(string); ok {
+			rules.CountryField = countryField
+		}
+	}
+
+	if v, ok := params[PostcodeRuleNameAllowedCountries]; ok {
+		countries, err := ConvertToStringArray(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid allowed countries: %w", err)
+		}
+		rules.AllowedCountries = countries
+	}
+
+	return rules, nil
+}
+
+// TODO: add validating parsed rules
+func parseCrossFieldRules(params map[string]any) (*CrossFieldRules, error) {
+	rules := &CrossFieldRules{}
+
+	if v, ok := params[BaseRuleNameRequired]; ok {
+		if required, ok := v.(bool); ok {
+			rules.Required = required
+		}
+	}
+
+	if v, ok := params[CrossFieldRuleNameEqField]; ok {
+		if field, ok := v.(string); ok {
+			rules.EqField = field
+		}
+	}
+
+	if v, ok := params[CrossFieldRuleNameGtField]; ok {
+		if field, ok := v.(string); ok {
+			rules.GtField = field
+		}
+	}
+
+	if v, ok := params[CrossFieldRuleNameLtField]; ok {
+		if field, ok := v.(string); ok {
+			rules.LtField = field
+		}
+	}
+
+	if v, ok := params[CrossFieldRuleNameMatchesField]; ok {
+		if field, ok := v.(string); ok {
+			rules.MatchesField = field
+		}
+	}
+
+	return rules, nil
+}
+
+// crossFieldLegacyKeys are CrossFieldRules' own params - rejected alongside
+// "expr" so a config migrated to the DSL doesn't silently ignore a leftover
+// single-sibling key from the shape it replaced.
+var crossFieldLegacyKeys = []string{
+	CrossFieldRuleNameEqField, CrossFieldRuleNameGtField,
+	CrossFieldRuleNameLtField, CrossFieldRuleNameMatchesField,
+}
+
+// parseCrossFieldConfig dispatches "cross_field" config to one of two
+// shapes: params with "expr" build a CrossFieldExprRules (a multi-field
+// comparison DSL, see cross_field_expr.go); anything else falls back to the
+// original single-sibling eq_field/gt_field/lt_field/matches_field shape
+// CrossFieldRules has always supported.
+func parseCrossFieldConfig(params map[string]any) (RuleSet, error) {
+	if _, ok := params["expr"]; ok {
+		for _, key := range crossFieldLegacyKeys {
+			if _, ok := params[key]; ok {
+				return nil, fmt.Errorf("cross_field: %q is not used alongside expr; remove it or drop expr to use the legacy single-sibling comparison", key)
+			}
+		}
+		return parseCrossFieldExprRules(params)
+	}
+	return parseCrossFieldRules(params)
+}
+
+func parseCrossFieldExprRules(params map[string]any) (*CrossFieldExprRules, error) {
+	rules := &CrossFieldExprRules{}
+
+	if v, ok := params["expr"]; ok {
+		expr, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("cross_field: expr must be a string")
+		}
+		rules.Expr = expr
+	}
+
+	if v, ok := params["when"]; ok {
+		when, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("cross_field: when must be a string")
+		}
+		rules.When = when
+	}
+
+	if v, ok := params["fields"]; ok {
+		list, ok := v.([]any)
+		if !ok {
+			return nil, fmt.Errorf("cross_field: fields must be a list of strings")
+		}
+		fields := make([]string, 0, len(list))
+		for _, item := range list {
+			field, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("cross_field: fields must be a list of strings")
+			}
+			fields = append(fields, field)
+		}
+		rules.Fields = fields
+	}
+
+	return rules, nil
+}
+
 type validationContext struct {
 	Path string
+	// Siblings holds the raw values of fields in the immediately enclosing
+	// struct, keyed by their sval tag, so ContextualRuleSet implementations
+	// (cross-field comparisons, RuleConfig.When) can resolve sibling paths.
+	Siblings map[string]any
 }
 
 func (v *validator) Validate(data any) error {
-	return v.validateRecursive(reflect.ValueOf(data), validationContext{Path: ""})
+	err := v.validateRecursive(reflect.ValueOf(data), validationContext{Path: ""})
+	defer v.emit(context.Background(), ValidationEvent{Topic: EventValidationCompleted})
+
+	if err == nil {
+		return nil
+	}
+
+	if v.translator != nil {
+		v.translateErrors(err.(*ValidationError))
+	}
+
+	return err
+}
+
+// translateErrors rewrites each error's Message in place using the
+// validator's Translator, so the existing ValidationError.Error() JSON
+// output picks up the localized text without any change to its own format.
+// Rule names and values the translator doesn't recognize keep their original
+// English message.
+func (v *validator) translateErrors(err *ValidationError) {
+	locale := v.locale
+	if locale == "" {
+		locale = "en"
+	}
+
+	for _, e := range err.errs {
+		if msg := v.translator.Translate(e.Rule, e.Expected, locale); msg != "" {
+			e.Message = msg
+		}
+	}
 }
 
 func (v *validator) validateRecursive(val reflect.Value, ctx validationContext) error {
@@ -633,6 +1452,8 @@ func (v *validator) validateRecursive(val reflect.Value, ctx validationContext)
 	case reflect.Struct:
 		errs := NewValidationError()
 		typ := val.Type()
+		siblings := collectSiblings(val, typ)
+
 		for i := 0; i < val.NumField(); i++ {
 			field := typ.Field(i)
 			fieldValue := val.Field(i)
@@ -646,13 +1467,17 @@ func (v *validator) validateRecursive(val reflect.Value, ctx validationContext)
 			if ctx.Path != "" {
 				currentPath = ctx.Path + "." + tag
 			}
-			currentCtx := validationContext{Path: currentPath}
+			currentCtx := validationContext{Path: currentPath, Siblings: siblings}
 
 			if err := v.validateRecursive(fieldValue, currentCtx); err != nil {
 				errs.AppendError(err.(*ValidationError))
 			}
 		}
 
+		if err := v.runCrossFieldRules(siblings, ctx.Path); err != nil {
+			errs.AppendError(err)
+		}
+
 		if errs.HasErrors() {
 			return errs
 		}
@@ -673,9 +1498,26 @@ func (v *validator) validateRecursive(val reflect.Value, ctx validationContext)
 			value = val.Interface()
 		}
 
+		v.emit(context.Background(), ValidationEvent{Topic: EventBeforeField, Field: ctx.Path, Value: value})
+
+		if ctxRules, ok := ruleSet.(ContextualRuleSet); ok && ctx.Siblings != nil {
+			if err := ctxRules.ValidateWithSiblings(value, ctx.Siblings); err != nil {
+				fieldErr := err.(*ValidationError)
+				fieldErr.AddContextToErrors(ctx.Path)
+				v.emitFieldFailures(context.Background(), ctx.Path, fieldErr)
+				return fieldErr
+			}
+			v.emit(context.Background(), ValidationEvent{Topic: EventAfterField, Field: ctx.Path, Value: value})
+			return nil
+		}
+
 		if err := ruleSet.Validate(value); err != nil {
-			return err
+			fieldErr := err.(*ValidationError)
+			fieldErr.AddContextToErrors(ctx.Path)
+			v.emitFieldFailures(context.Background(), ctx.Path, fieldErr)
+			return fieldErr
 		}
+		v.emit(context.Background(), ValidationEvent{Topic: EventAfterField, Field: ctx.Path, Value: value})
 		return nil
 	}
 }
@@ -703,6 +1545,34 @@ func normalizePath(path string) string {
 	return indexRegex.ReplaceAllString(path, "[]")
 }
 
+// collectSiblings reads every tagged field of a struct into a flat tag->value
+// map, one level deep, so ContextualRuleSet implementations can resolve
+// sibling paths relative to the struct being validated.
+func collectSiblings(val reflect.Value, typ reflect.Type) map[string]any {
+	siblings := make(map[string]any, val.NumField())
+
+	for i := 0; i < val.NumField(); i++ {
+		tag := typ.Field(i).Tag.Get("sval")
+		if tag == "" {
+			continue
+		}
+
+		fieldValue := val.Field(i)
+		if fieldValue.Kind() == reflect.Ptr {
+			if fieldValue.IsNil() {
+				continue
+			}
+			fieldValue = fieldValue.Elem()
+		}
+
+		if fieldValue.CanInterface() {
+			siblings[tag] = fieldValue.Interface()
+		}
+	}
+
+	return siblings
+}
+
 func (v validator) String() string {
 	var sb strings.Builder
 	for field, rules := range v.rules {
@@ -398,7 +398,7 @@ func TestCreateRuleSet(t *testing.T) {
 					"max_len":                64,
 					"min_upper":              2,
 					"min_lower":              2,
-					"min_digits":             2,
+					"min_numbers":            2,
 					"min_special":            2,
 					"special_chars":          "!@#$%^&*()",
 					"allowed_chars":          "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789!@#$%^&*()",
@@ -418,7 +418,7 @@ func TestCreateRuleSet(t *testing.T) {
 				MaxLen:       64,
 				MinUpper:     2,
 				MinLower:     2,
-				MinDigits:    2,
+				MinNumbers:   2,
 				MinSpecial:   2,
 				SpecialChars: []rune{'!', '@', '#', '$', '%', '^', '&', '*', '(', ')'},
 				AllowedChars: []rune{'a', 'b', 'c', 'd', 'e', 'f', 'g', 'h', 'i', 'j', 'k', 'l', 'm', 'n', 'o', 'p', 'q', 'r', 's', 't', 'u', 'v', 'w', 'x', 'y', 'z',
@@ -457,3 +457,78 @@ func TestCreateRuleSet(t *testing.T) {
 		})
 	}
 }
+
+func TestNewValidatorFromConfig_CompileErrors(t *testing.T) {
+	_, err := NewValidatorFromConfig(ValidatorConfig{
+		Rules: map[string]RuleConfig{
+			"name": {
+				Type: "string",
+				Params: map[string]any{
+					"regex": "(",
+				},
+			},
+			"ip": {
+				Type: "ip",
+				Params: map[string]any{
+					"allowed_subnets": []any{"not-a-cidr"},
+				},
+			},
+			"age": {
+				Type: "int",
+			},
+		},
+	})
+
+	assert.Error(t, err, "Expected ConfigError for an invalid regex and an invalid CIDR")
+
+	cfgErr, ok := err.(*ConfigError)
+	assert.True(t, ok, "Expected a *ConfigError")
+	assert.Len(t, cfgErr.Fields, 2)
+	assert.Contains(t, cfgErr.Fields, "name")
+	assert.Contains(t, cfgErr.Fields, "ip")
+}
+
+func TestValidator_Validate_FieldPath(t *testing.T) {
+	type Inner struct {
+		Password string `sval:"password"`
+	}
+	type Item struct {
+		Value int `sval:"value"`
+	}
+	type Outer struct {
+		Users []Item `sval:"users"`
+		Inner Inner  `sval:"inner"`
+	}
+
+	v, err := NewValidatorFromConfig(ValidatorConfig{
+		Rules: map[string]RuleConfig{
+			"users[].value": {
+				Type:   "int",
+				Params: map[string]any{"min": 0},
+			},
+			"inner.password": {
+				Type:   "string",
+				Params: map[string]any{"min_len": 10},
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	err = v.Validate(Outer{
+		Users: []Item{{Value: 1}, {Value: -1}, {Value: 2}},
+		Inner: Inner{Password: "short"},
+	})
+	assert.Error(t, err)
+
+	ve, ok := err.(*ValidationError)
+	assert.True(t, ok)
+
+	assert.Len(t, ve.ByField("users[1].value"), 1)
+	assert.Len(t, ve.ByField("inner.password"), 1)
+}
+
+// ptr is a shared test helper for building pointer-typed rule fields and
+// test inputs inline.
+func ptr[T any](v T) *T {
+	return &v
+}
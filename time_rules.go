@@ -1,9 +1,13 @@
 package sval
 
 import (
+	"encoding/json"
 	"fmt"
 	"slices"
+	"strings"
 	"time"
+
+	"gopkg.in/yaml.v3"
 )
 
 type TimeRuleName = string
@@ -22,6 +26,7 @@ const (
 	TimeRuleNameMinTime       TimeRuleName = "min_time"
 	TimeRuleNameMaxTime       TimeRuleName = "max_time"
 	TimeRuleNameBusinessHrs   TimeRuleName = "business_hours"
+	TimeRuleNameSchedule      TimeRuleName = "schedule"
 )
 
 type TimeRules struct {
@@ -41,10 +46,20 @@ type TimeRules struct {
 	Workday  bool           `json:"workday,omitempty" yaml:"workday,omitempty"`
 	Weekdays []time.Weekday `json:"weekdays,omitempty" yaml:"weekdays,omitempty"`
 	Holidays []time.Time    `json:"holidays,omitempty" yaml:"holidays,omitempty"`
+	// Calendars additionally rejects dates matched by a HolidayCalendar -
+	// recurring fixed dates, Nth-weekday-of-month dates, or Easter-relative
+	// dates - unlike Holidays' flat one-off date list. Not config-loadable
+	// (like EmailRules.Resolver), since a calendar is Go code or a parsed
+	// ICS file, not a JSON/YAML value.
+	Calendars []HolidayCalendar `json:"-" yaml:"-"`
 
 	MinTime     *time.Time     `json:"min_time,omitempty" yaml:"min_time,omitempty"`
 	MaxTime     *time.Time     `json:"max_time,omitempty" yaml:"max_time,omitempty"`
 	BusinessHrs *BusinessHours `json:"business_hours,omitempty" yaml:"business_hours,omitempty"`
+	// Schedule is a weekly recurring window, e.g. "open weekdays 09:00-18:00,
+	// Saturday mornings only". Unlike BusinessHrs it can express a different
+	// range per weekday instead of one range applied uniformly.
+	Schedule *Schedule `json:"schedule,omitempty" yaml:"schedule,omitempty"`
 }
 
 type BusinessHours struct {
@@ -54,6 +69,294 @@ type BusinessHours struct {
 	Timezone string         `json:"timezone" yaml:"timezone"` // e.g. "Europe/Moscow"
 }
 
+// ScheduleWindow is one allowed range within a day, expressed as durations
+// from midnight. End == 24h means "until the end of the day".
+type ScheduleWindow struct {
+	Start time.Duration
+	End   time.Duration
+}
+
+// Schedule is a 7-element weekly schedule indexed by time.Weekday, evaluated
+// in a single Timezone. Each day can carry zero or more ScheduleWindows
+// (e.g. a split morning/afternoon window), and AlwaysOn/AlwaysOff
+// override a day's windows entirely, the same way AdGuard's scheduled
+// blocked-services config lets a day be pinned open or closed regardless of
+// its configured hours. Build it with NewSchedule (or unmarshal it from
+// config) so the timezone is resolved once instead of on every Validate call.
+type Schedule struct {
+	Days      [7][]ScheduleWindow
+	AlwaysOn  []time.Weekday
+	AlwaysOff []time.Weekday
+	Timezone  string
+
+	loc *time.Location
+}
+
+var scheduleDayKeys = [7]string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+var scheduleDayNames = map[string]time.Weekday{
+	"sun": time.Sunday, "mon": time.Monday, "tue": time.Tuesday, "wed": time.Wednesday,
+	"thu": time.Thursday, "fri": time.Friday, "sat": time.Saturday,
+}
+
+// NewSchedule validates every day's windows, the AlwaysOn/AlwaysOff lists,
+// and resolves Timezone once.
+func NewSchedule(days [7][]ScheduleWindow, alwaysOn, alwaysOff []time.Weekday, timezone string) (*Schedule, error) {
+	for d, windows := range days {
+		sorted := slices.Clone(windows)
+		slices.SortFunc(sorted, func(a, b ScheduleWindow) int { return int(a.Start - b.Start) })
+		for i, w := range sorted {
+			if w.Start >= w.End {
+				return nil, fmt.Errorf("schedule: %s: start must be before end", scheduleDayKeys[d])
+			}
+			if i > 0 && w.Start < sorted[i-1].End {
+				return nil, fmt.Errorf("schedule: %s: windows overlap", scheduleDayKeys[d])
+			}
+		}
+	}
+
+	for _, wd := range alwaysOn {
+		if slices.Contains(alwaysOff, wd) {
+			return nil, fmt.Errorf("schedule: %s: cannot be both always-on and always-off", scheduleDayKeys[wd])
+		}
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("schedule: invalid timezone %q: %w", timezone, err)
+	}
+
+	return &Schedule{Days: days, AlwaysOn: alwaysOn, AlwaysOff: alwaysOff, Timezone: timezone, loc: loc}, nil
+}
+
+// allows reports whether t falls within the schedule's windows for its
+// weekday, after converting t into the schedule's timezone. AlwaysOff takes
+// priority over AlwaysOn, which in turn overrides that day's windows.
+func (s *Schedule) allows(t time.Time) (bool, error) {
+	loc := s.loc
+	if loc == nil {
+		var err error
+		loc, err = time.LoadLocation(s.Timezone)
+		if err != nil {
+			return false, fmt.Errorf("schedule: invalid timezone %q: %w", s.Timezone, err)
+		}
+		s.loc = loc
+	}
+
+	local := t.In(loc)
+	weekday := local.Weekday()
+
+	if slices.Contains(s.AlwaysOff, weekday) {
+		return false, nil
+	}
+	if slices.Contains(s.AlwaysOn, weekday) {
+		return true, nil
+	}
+
+	windows := s.Days[weekday]
+	if len(windows) == 0 {
+		return false, nil
+	}
+
+	midnight := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	elapsed := local.Sub(midnight)
+
+	for _, w := range windows {
+		if elapsed >= w.Start && elapsed < w.End {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// scheduleWire is the "mon: 09:00-12:00,13:00-18:00" wire format used by
+// both the YAML/JSON config and Schedule's own (Un)MarshalJSON/YAML, keeping
+// the two symmetric.
+type scheduleWire struct {
+	Mon       string   `json:"mon,omitempty" yaml:"mon,omitempty"`
+	Tue       string   `json:"tue,omitempty" yaml:"tue,omitempty"`
+	Wed       string   `json:"wed,omitempty" yaml:"wed,omitempty"`
+	Thu       string   `json:"thu,omitempty" yaml:"thu,omitempty"`
+	Fri       string   `json:"fri,omitempty" yaml:"fri,omitempty"`
+	Sat       string   `json:"sat,omitempty" yaml:"sat,omitempty"`
+	Sun       string   `json:"sun,omitempty" yaml:"sun,omitempty"`
+	AlwaysOn  []string `json:"always_on,omitempty" yaml:"always_on,omitempty"`
+	AlwaysOff []string `json:"always_off,omitempty" yaml:"always_off,omitempty"`
+	Timezone  string   `json:"timezone" yaml:"timezone"`
+}
+
+func (s Schedule) toWire() scheduleWire {
+	format := func(windows []ScheduleWindow) string {
+		parts := make([]string, len(windows))
+		for i, w := range windows {
+			parts[i] = formatClock(w.Start) + "-" + formatClock(w.End)
+		}
+		return strings.Join(parts, ",")
+	}
+
+	formatDays := func(days []time.Weekday) []string {
+		names := make([]string, len(days))
+		for i, d := range days {
+			names[i] = scheduleDayKeys[d]
+		}
+		return names
+	}
+
+	return scheduleWire{
+		Sun:       format(s.Days[time.Sunday]),
+		Mon:       format(s.Days[time.Monday]),
+		Tue:       format(s.Days[time.Tuesday]),
+		Wed:       format(s.Days[time.Wednesday]),
+		Thu:       format(s.Days[time.Thursday]),
+		Fri:       format(s.Days[time.Friday]),
+		Sat:       format(s.Days[time.Saturday]),
+		AlwaysOn:  formatDays(s.AlwaysOn),
+		AlwaysOff: formatDays(s.AlwaysOff),
+		Timezone:  s.Timezone,
+	}
+}
+
+func (s *Schedule) fromWire(wire scheduleWire) error {
+	var days [7][]ScheduleWindow
+	raw := map[time.Weekday]string{
+		time.Sunday: wire.Sun, time.Monday: wire.Mon, time.Tuesday: wire.Tue,
+		time.Wednesday: wire.Wed, time.Thursday: wire.Thu, time.Friday: wire.Fri,
+		time.Saturday: wire.Sat,
+	}
+
+	for weekday, val := range raw {
+		if val == "" {
+			continue
+		}
+
+		windows, err := parseDayRanges(val)
+		if err != nil {
+			return fmt.Errorf("schedule: %s: %w", scheduleDayKeys[weekday], err)
+		}
+		days[weekday] = windows
+	}
+
+	alwaysOn, err := parseWeekdayNames(wire.AlwaysOn)
+	if err != nil {
+		return fmt.Errorf("schedule: always_on: %w", err)
+	}
+
+	alwaysOff, err := parseWeekdayNames(wire.AlwaysOff)
+	if err != nil {
+		return fmt.Errorf("schedule: always_off: %w", err)
+	}
+
+	built, err := NewSchedule(days, alwaysOn, alwaysOff, wire.Timezone)
+	if err != nil {
+		return err
+	}
+
+	*s = *built
+	return nil
+}
+
+// parseWeekdayNames resolves "mon".."sun" day names into time.Weekday
+// values, used by Schedule's AlwaysOn/AlwaysOff wire fields.
+func parseWeekdayNames(names []string) ([]time.Weekday, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	days := make([]time.Weekday, 0, len(names))
+	for _, name := range names {
+		weekday, ok := scheduleDayNames[strings.ToLower(name)]
+		if !ok {
+			return nil, fmt.Errorf("invalid weekday %q", name)
+		}
+		days = append(days, weekday)
+	}
+	return days, nil
+}
+
+func (s Schedule) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.toWire())
+}
+
+func (s *Schedule) UnmarshalJSON(data []byte) error {
+	var wire scheduleWire
+	if err := json.Unmarshal(data, &wire); err != nil {
+		return err
+	}
+	return s.fromWire(wire)
+}
+
+func (s Schedule) MarshalYAML() (any, error) {
+	return s.toWire(), nil
+}
+
+func (s *Schedule) UnmarshalYAML(value *yaml.Node) error {
+	var wire scheduleWire
+	if err := value.Decode(&wire); err != nil {
+		return err
+	}
+	return s.fromWire(wire)
+}
+
+func formatClock(d time.Duration) string {
+	h := int(d / time.Hour)
+	m := int((d % time.Hour) / time.Minute)
+	return fmt.Sprintf("%02d:%02d", h, m)
+}
+
+func parseClock(s string) (time.Duration, error) {
+	h, m, err := parseTimeHM(s, time.UTC)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute, nil
+}
+
+// parseDayRanges parses a comma-separated list of "HH:MM-HH:MM" entries,
+// e.g. "09:00-12:00,13:00-18:00" for a day with a lunch break.
+func parseDayRanges(s string) ([]ScheduleWindow, error) {
+	parts := strings.Split(s, ",")
+	windows := make([]ScheduleWindow, 0, len(parts))
+	for _, part := range parts {
+		window, err := parseDayRange(strings.TrimSpace(part))
+		if err != nil {
+			return nil, err
+		}
+		windows = append(windows, window)
+	}
+	return windows, nil
+}
+
+// parseDayRange parses a single "HH:MM-HH:MM" entry, treating "24:00" as the
+// end of day.
+func parseDayRange(s string) (ScheduleWindow, error) {
+	start, end, found := strings.Cut(s, "-")
+	if !found {
+		return ScheduleWindow{}, fmt.Errorf("invalid range %q, want HH:MM-HH:MM", s)
+	}
+
+	startDur, err := parseClock(start)
+	if err != nil {
+		return ScheduleWindow{}, fmt.Errorf("invalid start %q: %w", start, err)
+	}
+
+	var endDur time.Duration
+	if end == "24:00" {
+		endDur = 24 * time.Hour
+	} else {
+		endDur, err = parseClock(end)
+		if err != nil {
+			return ScheduleWindow{}, fmt.Errorf("invalid end %q: %w", end, err)
+		}
+	}
+
+	if startDur >= endDur {
+		return ScheduleWindow{}, fmt.Errorf("start must be before end in %q", s)
+	}
+
+	return ScheduleWindow{Start: startDur, End: endDur}, nil
+}
+
 func parseTimeString(s string, formats []string, timezones []string) (time.Time, error) {
 	// TODO: make map with all formats from time package
 	defaultFormats := []string{
@@ -215,6 +518,13 @@ func (r *TimeRules) Validate(i any) error {
 		}
 	}
 
+	for _, cal := range r.Calendars {
+		if isHoliday, name := cal.IsHoliday(t); isHoliday {
+			err.AddError(TimeRuleNameHolidays, name, t, fmt.Sprintf("date cannot be a holiday (%s)", name))
+			break
+		}
+	}
+
 	loc := time.UTC
 
 	if len(r.Timezones) > 0 {
@@ -290,6 +600,17 @@ func (r *TimeRules) Validate(i any) error {
 		}
 	}
 
+	if r.Schedule != nil {
+		allowed, scheduleErr := r.Schedule.allows(t)
+		if scheduleErr != nil {
+			err.AddError(TimeRuleNameTimezones, r.Schedule.Timezone, t, "invalid schedule timezone")
+			return err
+		}
+		if !allowed {
+			err.AddError(TimeRuleNameSchedule, r.Schedule, t, "time is outside the allowed weekly schedule")
+		}
+	}
+
 	if err.HasErrors() {
 		return err
 	}
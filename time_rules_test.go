@@ -301,3 +301,107 @@ func TestTimeRules(t *testing.T) {
 		})
 	}
 }
+
+func TestSchedule_MultipleWindowsPerDay(t *testing.T) {
+	days := [7][]ScheduleWindow{}
+	days[time.Monday] = []ScheduleWindow{
+		{Start: 9 * time.Hour, End: 12 * time.Hour},
+		{Start: 13 * time.Hour, End: 18 * time.Hour},
+	}
+
+	schedule, err := NewSchedule(days, nil, nil, "UTC")
+	assert.NoError(t, err)
+
+	morning := time.Date(2025, 8, 4, 10, 0, 0, 0, time.UTC) // Monday
+	lunch := time.Date(2025, 8, 4, 12, 30, 0, 0, time.UTC)
+	afternoon := time.Date(2025, 8, 4, 14, 0, 0, 0, time.UTC)
+	tuesday := time.Date(2025, 8, 5, 10, 0, 0, 0, time.UTC)
+
+	allowed, err := schedule.allows(morning)
+	assert.NoError(t, err)
+	assert.True(t, allowed)
+
+	allowed, err = schedule.allows(lunch)
+	assert.NoError(t, err)
+	assert.False(t, allowed)
+
+	allowed, err = schedule.allows(afternoon)
+	assert.NoError(t, err)
+	assert.True(t, allowed)
+
+	allowed, err = schedule.allows(tuesday)
+	assert.NoError(t, err)
+	assert.False(t, allowed)
+}
+
+func TestSchedule_AlwaysOnAlwaysOff(t *testing.T) {
+	days := [7][]ScheduleWindow{}
+	days[time.Saturday] = []ScheduleWindow{{Start: 9 * time.Hour, End: 12 * time.Hour}}
+
+	schedule, err := NewSchedule(days, []time.Weekday{time.Friday}, []time.Weekday{time.Sunday}, "UTC")
+	assert.NoError(t, err)
+
+	friday := time.Date(2025, 8, 1, 23, 0, 0, 0, time.UTC)
+	sunday := time.Date(2025, 8, 3, 1, 0, 0, 0, time.UTC)
+	saturdayMorning := time.Date(2025, 8, 2, 10, 0, 0, 0, time.UTC)
+	saturdayEvening := time.Date(2025, 8, 2, 20, 0, 0, 0, time.UTC)
+
+	allowed, err := schedule.allows(friday)
+	assert.NoError(t, err)
+	assert.True(t, allowed, "Friday is always-on regardless of time")
+
+	allowed, err = schedule.allows(sunday)
+	assert.NoError(t, err)
+	assert.False(t, allowed, "Sunday is always-off regardless of time")
+
+	allowed, err = schedule.allows(saturdayMorning)
+	assert.NoError(t, err)
+	assert.True(t, allowed)
+
+	allowed, err = schedule.allows(saturdayEvening)
+	assert.NoError(t, err)
+	assert.False(t, allowed)
+}
+
+func TestSchedule_OverlappingWindowsRejected(t *testing.T) {
+	days := [7][]ScheduleWindow{}
+	days[time.Monday] = []ScheduleWindow{
+		{Start: 9 * time.Hour, End: 14 * time.Hour},
+		{Start: 13 * time.Hour, End: 18 * time.Hour},
+	}
+
+	_, err := NewSchedule(days, nil, nil, "UTC")
+	assert.Error(t, err)
+}
+
+func TestSchedule_AlwaysOnAndOffConflict(t *testing.T) {
+	_, err := NewSchedule([7][]ScheduleWindow{}, []time.Weekday{time.Monday}, []time.Weekday{time.Monday}, "UTC")
+	assert.Error(t, err)
+}
+
+func TestSchedule_FromWireParsesCommaSeparatedWindows(t *testing.T) {
+	var schedule Schedule
+	err := schedule.fromWire(scheduleWire{
+		Mon:      "09:00-12:00,13:00-18:00",
+		AlwaysOn: []string{"fri"},
+		Timezone: "UTC",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, schedule.Days[time.Monday], 2)
+	assert.Equal(t, []time.Weekday{time.Friday}, schedule.AlwaysOn)
+}
+
+func TestTimeRules_Schedule(t *testing.T) {
+	schedule, err := NewSchedule([7][]ScheduleWindow{
+		time.Monday: {{Start: 9 * time.Hour, End: 18 * time.Hour}},
+	}, nil, nil, "UTC")
+	assert.NoError(t, err)
+
+	rules := TimeRules{Schedule: schedule}
+
+	monday := time.Date(2025, 8, 4, 10, 0, 0, 0, time.UTC).Format(time.RFC3339)
+	tuesday := time.Date(2025, 8, 5, 10, 0, 0, 0, time.UTC).Format(time.RFC3339)
+
+	assert.NoError(t, rules.Validate(monday))
+	assert.Error(t, rules.Validate(tuesday))
+}
@@ -0,0 +1,75 @@
+package sval
+
+type URIRuleName = string
+
+const (
+	URIRuleNamePermittedDomains URIRuleName = "permitted_domains"
+	URIRuleNameExcludedDomains  URIRuleName = "excluded_domains"
+)
+
+// URIRules checks a URI's host against RFC 5280 style name constraints,
+// alongside the broader scheme/TLD/userinfo validation in URLRules. Unlike
+// URLRules, it exists purely for the permitted/excluded-domain use case
+// shared with DNSRules, EmailRules, and IPRules (see constraints.go).
+type URIRules struct {
+	BaseRules
+	PermittedDomains []string `json:"permitted_domains,omitempty" yaml:"permitted_domains"`
+	ExcludedDomains  []string `json:"excluded_domains,omitempty" yaml:"excluded_domains"`
+}
+
+func (r *URIRules) Validate(i any) error {
+	err := NewValidationError()
+
+	if i == nil {
+		if r.Required {
+			err.AddError(BaseRuleNameRequired, r.Required, i, FieldIsRequired)
+			return err
+		}
+		return nil
+	}
+
+	if ptr, ok := i.(*string); ok {
+		if ptr == nil {
+			if r.Required {
+				err.AddError(BaseRuleNameRequired, r.Required, i, FieldIsRequired)
+				return err
+			}
+			return nil
+		}
+		i = *ptr
+	}
+
+	val, ok := i.(string)
+	if !ok {
+		err.AddError(BaseRuleNameType, TypeURI, i, "value must be a string")
+		return err
+	}
+
+	if val == "" {
+		if r.Required {
+			err.AddError(BaseRuleNameRequired, r.Required, i, FieldIsRequired)
+			return err
+		}
+		return nil
+	}
+
+	if len(r.PermittedDomains) == 0 && len(r.ExcludedDomains) == 0 {
+		return nil
+	}
+
+	allowed, reason := matchURIConstraint(val, r.PermittedDomains, r.ExcludedDomains)
+	if !allowed {
+		if reason == ConstraintReasonCannotParseURI {
+			err.AddError(BaseRuleNameType, TypeURI, i, reasonMessage(reason, "URI"))
+			return err
+		}
+		rule := URIRuleNamePermittedDomains
+		if reason == ConstraintReasonExcluded {
+			rule = URIRuleNameExcludedDomains
+		}
+		err.AddError(rule, reasonRuleValue(r.PermittedDomains, r.ExcludedDomains, reason), i, reasonMessage(reason, "URI host"))
+		return err
+	}
+
+	return nil
+}
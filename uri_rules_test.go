@@ -0,0 +1,88 @@
+package sval
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestURIRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   URIRules
+		value   any
+		wantErr bool
+	}{
+		{
+			name:    "empty string when not required",
+			rules:   URIRules{},
+			value:   "",
+			wantErr: false,
+		},
+		{
+			name:    "empty string when required",
+			rules:   URIRules{BaseRules: BaseRules{Required: true}},
+			value:   "",
+			wantErr: true,
+		},
+		{
+			name:    "nil value when required",
+			rules:   URIRules{BaseRules: BaseRules{Required: true}},
+			value:   nil,
+			wantErr: true,
+		},
+		{
+			name:    "non-string value",
+			rules:   URIRules{},
+			value:   123,
+			wantErr: true,
+		},
+		{
+			name:    "no constraints configured",
+			rules:   URIRules{},
+			value:   "https://example.com/path",
+			wantErr: false,
+		},
+		{
+			name:    "matches permitted host domain",
+			rules:   URIRules{PermittedDomains: []string{"example.com"}},
+			value:   "https://example.com/path",
+			wantErr: false,
+		},
+		{
+			name:    "matches permitted host subdomain",
+			rules:   URIRules{PermittedDomains: []string{".example.com"}},
+			value:   "https://api.example.com/v1",
+			wantErr: false,
+		},
+		{
+			name:    "host does not match permitted domain",
+			rules:   URIRules{PermittedDomains: []string{"example.com"}},
+			value:   "https://other.com/path",
+			wantErr: true,
+		},
+		{
+			name:    "host matches excluded domain",
+			rules:   URIRules{ExcludedDomains: []string{"blocked.com"}},
+			value:   "https://blocked.com/",
+			wantErr: true,
+		},
+		{
+			name:    "unparsable URI",
+			rules:   URIRules{PermittedDomains: []string{"example.com"}},
+			value:   "://not-a-uri",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rules.Validate(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err, "Expected error for %s with value %v", tt.name, tt.value)
+			} else {
+				assert.NoError(t, err, "Unexpected error for %s with value %v", tt.name, tt.value)
+			}
+		})
+	}
+}
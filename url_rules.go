@@ -0,0 +1,151 @@
+package sval
+
+import (
+	"net"
+	"net/url"
+	"strings"
+)
+
+type URLRuleName = string
+
+const (
+	URLRuleNameAllowedSchemes   URLRuleName = "allowed_schemes"
+	URLRuleNameRequireHost      URLRuleName = "require_host"
+	URLRuleNameAllowedTLDs      URLRuleName = "allowed_tlds"
+	URLRuleNameDisallowUserinfo URLRuleName = "disallow_userinfo"
+	URLRuleNameMaxLen           URLRuleName = "max_len"
+	URLRuleNameResolveDNS       URLRuleName = "resolve_dns"
+)
+
+// TLDResolver decides whether host's top-level domain is in allowed.
+// URLRules.AllowedTLDs uses the package default (suffix match); callers
+// that need a real public-suffix list can implement this and set
+// URLRules.TLDResolver instead.
+type TLDResolver interface {
+	IsAllowedTLD(host string, allowed []string) bool
+}
+
+type suffixTLDResolver struct{}
+
+func (suffixTLDResolver) IsAllowedTLD(host string, allowed []string) bool {
+	host = strings.ToLower(host)
+	for _, tld := range allowed {
+		tld = strings.ToLower(tld)
+		if host == tld || strings.HasSuffix(host, "."+tld) {
+			return true
+		}
+	}
+	return false
+}
+
+// URLRules validates a URL string, alongside the adjacent IPRules,
+// CIDRRules, and MACRules network-primitive validators.
+type URLRules struct {
+	BaseRules
+	AllowedSchemes []string `json:"allowed_schemes,omitempty" yaml:"allowed_schemes"`
+	RequireHost    bool     `json:"require_host" yaml:"require_host"`
+	AllowedTLDs    []string `json:"allowed_tlds,omitempty" yaml:"allowed_tlds"`
+	// DisallowUserinfo rejects URLs carrying a "user:pass@" component.
+	DisallowUserinfo bool `json:"disallow_userinfo" yaml:"disallow_userinfo"`
+	MaxLen           int  `json:"max_len" yaml:"max_len"`
+	// ResolveDNS additionally requires the host to resolve via net.LookupHost.
+	// Off by default, since it makes validation depend on network access.
+	ResolveDNS bool `json:"resolve_dns" yaml:"resolve_dns"`
+	// TLDResolver overrides how AllowedTLDs is matched against the host.
+	// A nil value falls back to a plain case-insensitive suffix match.
+	TLDResolver TLDResolver `json:"-" yaml:"-"`
+}
+
+func (r *URLRules) Validate(i any) error {
+	err := NewValidationError()
+
+	if i == nil {
+		if r.Required {
+			err.AddError(BaseRuleNameRequired, r.Required, i, FieldIsRequired)
+			return err
+		}
+		return nil
+	}
+
+	if ptr, ok := i.(*string); ok {
+		if ptr == nil {
+			if r.Required {
+				err.AddError(BaseRuleNameRequired, r.Required, i, FieldIsRequired)
+				return err
+			}
+			return nil
+		}
+		i = *ptr
+	}
+
+	val, ok := i.(string)
+	if !ok {
+		err.AddError(BaseRuleNameType, TypeString, i, "value must be a string")
+		return err
+	}
+
+	if val == "" {
+		if r.Required {
+			err.AddError(BaseRuleNameRequired, r.Required, i, FieldIsRequired)
+			return err
+		}
+		return nil
+	}
+
+	if r.MaxLen > 0 && len(val) > r.MaxLen {
+		err.AddError(URLRuleNameMaxLen, r.MaxLen, i, "URL exceeds max length")
+		return err
+	}
+
+	parsed, errParse := url.Parse(val)
+	if errParse != nil {
+		err.AddError(BaseRuleNameType, TypeURL, i, "invalid URL format")
+		return err
+	}
+
+	if len(r.AllowedSchemes) > 0 {
+		allowed := false
+		for _, scheme := range r.AllowedSchemes {
+			if strings.EqualFold(scheme, parsed.Scheme) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			err.AddError(URLRuleNameAllowedSchemes, r.AllowedSchemes, i, "URL scheme is not allowed")
+			return err
+		}
+	}
+
+	if r.RequireHost && parsed.Host == "" {
+		err.AddError(URLRuleNameRequireHost, r.RequireHost, i, "URL must have a host")
+		return err
+	}
+
+	if r.DisallowUserinfo && parsed.User != nil {
+		err.AddError(URLRuleNameDisallowUserinfo, r.DisallowUserinfo, i, "URL must not contain userinfo")
+		return err
+	}
+
+	host := parsed.Hostname()
+
+	if len(r.AllowedTLDs) > 0 {
+		resolver := r.TLDResolver
+		if resolver == nil {
+			resolver = suffixTLDResolver{}
+		}
+		if host == "" || !resolver.IsAllowedTLD(host, r.AllowedTLDs) {
+			err.AddError(URLRuleNameAllowedTLDs, r.AllowedTLDs, i, "URL top-level domain is not allowed")
+			return err
+		}
+	}
+
+	if r.ResolveDNS && host != "" {
+		if _, errLookup := net.LookupHost(host); errLookup != nil {
+			err.AddError(URLRuleNameResolveDNS, r.ResolveDNS, i, "URL host could not be resolved")
+			return err
+		}
+	}
+
+	return nil
+}
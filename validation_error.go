@@ -4,16 +4,24 @@ import (
 	"encoding/json"
 )
 
-type ValidationError struct {
-	Errors []*valError `json:"errors" yaml:"errors"`
+// FieldError is one failure recorded against a single field: the dot/bracket
+// Field path it was found at (e.g. "Users[3].Password", populated by the
+// struct-walking validator from the sval:"..." tags - empty when a rule is
+// validated directly rather than through a Validator), which Rule produced
+// it, a machine-readable Reason (ReasonUnspecified for rules that haven't
+// adopted AddReasonError yet), the rule's configured constraint (Expected)
+// and the value that violated it (Got), and a human-readable Message.
+type FieldError struct {
+	Field    string `json:"field" yaml:"field"`
+	Rule     string `json:"rule" yaml:"rule"`
+	Reason   Reason `json:"reason,omitempty" yaml:"reason,omitempty"`
+	Expected any    `json:"expected,omitempty" yaml:"expected,omitempty"`
+	Got      any    `json:"got,omitempty" yaml:"got,omitempty"`
+	Message  string `json:"message" yaml:"message"`
 }
 
-type valError struct {
-	Field      string `json:"field" yaml:"field"`
-	Rule       string `json:"rule" yaml:"rule"`
-	RuleValues any    `json:"rule_values,omitempty" yaml:"rule_values,omitempty"`
-	Provided   any    `json:"provided,omitempty" yaml:"provided,omitempty"`
-	Message    string `json:"message" yaml:"message"`
+type ValidationError struct {
+	errs []*FieldError
 }
 
 // JSON formatted as string
@@ -25,9 +33,17 @@ func (e *ValidationError) Error() string {
 	return string(data)
 }
 
+// MarshalJSON keeps the long-standing {"errors": [...]} shape, now with each
+// entry carrying FieldError's field/rule/reason/expected/got/message keys.
+func (e *ValidationError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Errors []*FieldError `json:"errors"`
+	}{Errors: e.errs})
+}
+
 func NewValidationError() *ValidationError {
 	return &ValidationError{
-		Errors: make([]*valError, 0),
+		errs: make([]*FieldError, 0),
 	}
 }
 
@@ -38,7 +54,7 @@ func NewValidationErrorWithField(field string) *ValidationError {
 }
 
 func (e *ValidationError) AddContextToErrors(field string) {
-	for _, err := range e.Errors {
+	for _, err := range e.errs {
 		if err.Field == "" {
 			err.Field = field
 		} else if field != "" {
@@ -47,29 +63,129 @@ func (e *ValidationError) AddContextToErrors(field string) {
 	}
 }
 
+// AddError appends a field failure without a machine-readable Reason. This
+// is what most rule types still call; see AddReasonError for the ones
+// migrated to typed reasons.
 func (e *ValidationError) AddError(rule string, ruleValue, provided any, message string) {
-	e.Errors = append(e.Errors, &valError{
-		Field:      "",
-		Rule:       rule,
-		RuleValues: ruleValue,
-		Provided:   provided,
-		Message:    message,
+	e.errs = append(e.errs, &FieldError{
+		Field:    "",
+		Rule:     rule,
+		Expected: ruleValue,
+		Got:      provided,
+		Message:  message,
+	})
+}
+
+// AddReasonError behaves like AddError but also records a Reason, so
+// callers can branch on a failure's Errors()[i].Reason (or errors.Is with
+// AnyReason) instead of parsing Message or comparing Rule strings.
+func (e *ValidationError) AddReasonError(rule string, reason Reason, ruleValue, provided any, message string) {
+	e.errs = append(e.errs, &FieldError{
+		Field:    "",
+		Rule:     rule,
+		Reason:   reason,
+		Expected: ruleValue,
+		Got:      provided,
+		Message:  message,
 	})
 }
 
 func (e *ValidationError) AppendError(err *ValidationError) {
-	if err == nil || len(err.Errors) == 0 {
+	if err == nil || len(err.errs) == 0 {
 		return
 	}
 
 	if !e.HasErrors() {
-		e.Errors = make([]*valError, 0, len(err.Errors))
+		e.errs = make([]*FieldError, 0, len(err.errs))
 	}
 
-	e.Errors = append(e.Errors, err.Errors...)
-
+	e.errs = append(e.errs, err.errs...)
 }
 
 func (e *ValidationError) HasErrors() bool {
-	return len(e.Errors) > 0
+	return len(e.errs) > 0
+}
+
+// Errors returns every FieldError recorded so far, in the order they were
+// added.
+func (e *ValidationError) Errors() []FieldError {
+	out := make([]FieldError, len(e.errs))
+	for i, fe := range e.errs {
+		out[i] = *fe
+	}
+	return out
+}
+
+// First returns the first recorded FieldError, or nil if there are none.
+func (e *ValidationError) First() *FieldError {
+	if len(e.errs) == 0 {
+		return nil
+	}
+	first := *e.errs[0]
+	return &first
+}
+
+// ByField returns every FieldError recorded against the given Field path.
+func (e *ValidationError) ByField(path string) []FieldError {
+	var out []FieldError
+	for _, fe := range e.errs {
+		if fe.Field == path {
+			out = append(out, *fe)
+		}
+	}
+	return out
+}
+
+// reasonError is the sentinel error type returned by AnyReason, compared
+// against by Is.
+type reasonError struct{ reason Reason }
+
+func (r reasonError) Error() string {
+	return "validation reason: " + string(r.reason)
+}
+
+// AnyReason returns a sentinel error for use with errors.Is(err, AnyReason(r)):
+// matches any *ValidationError that has at least one FieldError with Reason
+// r, without the caller ranging over Errors() itself.
+func AnyReason(r Reason) error {
+	return reasonError{reason: r}
+}
+
+// Is implements the errors.Is interface so AnyReason can be used with the
+// standard errors package.
+func (e *ValidationError) Is(target error) bool {
+	re, ok := target.(reasonError)
+	if !ok {
+		return false
+	}
+	for _, fe := range e.errs {
+		if fe.Reason == re.reason {
+			return true
+		}
+	}
+	return false
+}
+
+// Translate renders every recorded FieldError through DefaultTranslator for
+// locale, keyed by Field (falling back to Rule for errors with no field
+// path, e.g. one validated directly rather than through a Validator).
+// A FieldError whose Rule has no template for locale keeps its original
+// Message, so an unregistered locale degrades to the rule's built-in text
+// instead of an empty string.
+func (e *ValidationError) Translate(locale string) map[string]string {
+	out := make(map[string]string, len(e.errs))
+	for _, fe := range e.errs {
+		key := fe.Field
+		if key == "" {
+			key = fe.Rule
+		}
+
+		msg := DefaultTranslator{}.Translate(fe.Rule, fe.Expected, locale)
+		if msg == "" {
+			msg = fe.Message
+		}
+
+		out[key] = msg
+	}
+	return out
 }
@@ -0,0 +1,104 @@
+package sval
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidationError_ErrorsFirstByField(t *testing.T) {
+	err := NewValidationError()
+	err.AddReasonError(StringRuleNameMinLen, ReasonMinLen, 10, "abc", "string too short")
+	err.AddError(StringRuleNameRegex, `^[a-z]+$`, "abc123", "string does not match pattern")
+	err.AddContextToErrors("Password")
+
+	fieldErrs := err.Errors()
+	assert.Len(t, fieldErrs, 2)
+	assert.Equal(t, "Password", fieldErrs[0].Field)
+
+	first := err.First()
+	assert.Equal(t, ReasonMinLen, first.Reason)
+
+	byField := err.ByField("Password")
+	assert.Len(t, byField, 2)
+	assert.Empty(t, err.ByField("Other"))
+}
+
+func TestValidationError_Is(t *testing.T) {
+	err := NewValidationError()
+	err.AddReasonError(StringRuleNameMinLen, ReasonMinLen, 10, "abc", "string too short")
+
+	assert.True(t, errors.Is(err, AnyReason(ReasonMinLen)))
+	assert.False(t, errors.Is(err, AnyReason(ReasonMaxLen)))
+}
+
+// FieldError and []FieldError are both plain structs rather than error
+// types, so they can never satisfy errors.As's "*target must implement
+// error, or be an interface" contract - pulling structured data out of a
+// *ValidationError goes through First()/Errors() directly instead.
+func TestValidationError_FirstAndErrors(t *testing.T) {
+	err := NewValidationError()
+	err.AddReasonError(StringRuleNameMinLen, ReasonMinLen, 10, "abc", "string too short")
+	err.AddReasonError(StringRuleNameRegex, ReasonRegexMismatch, `^[a-z]+$`, "abc", "string does not match pattern")
+
+	fe := err.First()
+	assert.NotNil(t, fe)
+	assert.Equal(t, ReasonMinLen, fe.Reason)
+
+	fes := err.Errors()
+	assert.Len(t, fes, 2)
+
+	empty := NewValidationError()
+	assert.Nil(t, empty.First())
+}
+
+func TestValidationError_Translate(t *testing.T) {
+	err := NewValidationError()
+	err.AddError(PasswordRuleNameMinUpper, 2, "abc", "password must contain uppercase characters")
+	err.AddContextToErrors("password")
+
+	en := err.Translate("en")
+	assert.Equal(t, "must contain at least 2 uppercase characters", en["password"])
+
+	ru := err.Translate("ru")
+	assert.Equal(t, "должен содержать не менее 2 заглавных букв", ru["password"])
+
+	unregistered := err.Translate("fr")
+	assert.Equal(t, "password must contain uppercase characters", unregistered["password"])
+}
+
+func TestValidationError_Translate_NoField(t *testing.T) {
+	err := NewValidationError()
+	err.AddError(PasswordRuleNameBreached, 5, "hunter2", "password found in 5 known breaches")
+
+	en := err.Translate("en")
+	assert.Equal(t, "appears in 5 known data breaches", en[PasswordRuleNameBreached])
+}
+
+func TestValidationError_MarshalJSON(t *testing.T) {
+	err := NewValidationError()
+	err.AddReasonError(StringRuleNameMinLen, ReasonMinLen, 10, "abc", "string too short")
+	err.AddContextToErrors("Password")
+
+	data, marshalErr := json.Marshal(err)
+	assert.NoError(t, marshalErr)
+
+	var decoded struct {
+		Errors []struct {
+			Field    string `json:"field"`
+			Rule     string `json:"rule"`
+			Reason   string `json:"reason"`
+			Expected any    `json:"expected"`
+			Got      any    `json:"got"`
+			Message  string `json:"message"`
+		} `json:"errors"`
+	}
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Len(t, decoded.Errors, 1)
+	assert.Equal(t, "Password", decoded.Errors[0].Field)
+	assert.Equal(t, "MIN_LEN", decoded.Errors[0].Reason)
+	assert.Equal(t, float64(10), decoded.Errors[0].Expected)
+	assert.Equal(t, "abc", decoded.Errors[0].Got)
+}
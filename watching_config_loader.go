@@ -0,0 +1,190 @@
+package sval
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// defaultWatchInterval is used when WithWatchInterval isn't passed to
+// NewWatching.
+const defaultWatchInterval = 2 * time.Second
+
+// WatchingConfigLoader wraps a FileConfigLoader and polls its file's mtime
+// to detect edits, so NewWatching can rebuild the validator without a
+// redeploy. It polls rather than using a filesystem-event API like fsnotify
+// because this module has no dependency manifest to vendor one into; the
+// ConfigLoader interface it satisfies means a future fsnotify-backed loader
+// can drop in without changing NewWatching.
+type WatchingConfigLoader struct {
+	*FileConfigLoader
+}
+
+// NewWatchingConfigLoader wraps loader for use with NewWatching.
+func NewWatchingConfigLoader(loader *FileConfigLoader) *WatchingConfigLoader {
+	return &WatchingConfigLoader{FileConfigLoader: loader}
+}
+
+func (l *WatchingConfigLoader) modTime() (time.Time, error) {
+	info, err := os.Stat(l.Path)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return info.ModTime(), nil
+}
+
+// ReloadHook is invoked after every reload attempt NewWatching's watcher
+// makes, successful or not, so an application can log a swap or notice a bad
+// edit that failed to compile and left the previous config in place.
+type ReloadHook func(oldCfg, newCfg ValidatorConfig, err error)
+
+// WatchOption configures NewWatching. It mirrors the Option pattern used to
+// configure a plain validator (WithTranslator, WithLocale, ...), but is its
+// own type since it configures the watch loop rather than the validator
+// itself.
+type WatchOption func(*watchSettings)
+
+type watchSettings struct {
+	interval      time.Duration
+	onReload      ReloadHook
+	validatorOpts []Option
+}
+
+// WithWatchInterval sets how often NewWatching polls the config file for
+// changes. Defaults to defaultWatchInterval.
+func WithWatchInterval(d time.Duration) WatchOption {
+	return func(s *watchSettings) {
+		if d > 0 {
+			s.interval = d
+		}
+	}
+}
+
+// WithOnReload sets the hook NewWatching calls after each reload attempt.
+func WithOnReload(fn ReloadHook) WatchOption {
+	return func(s *watchSettings) {
+		s.onReload = fn
+	}
+}
+
+// WithWatchValidatorOptions passes opts through to every NewValidatorFromConfig
+// call NewWatching makes, including the initial build.
+func WithWatchValidatorOptions(opts ...Option) WatchOption {
+	return func(s *watchSettings) {
+		s.validatorOpts = opts
+	}
+}
+
+// WatchingValidator is a *validator behind an atomic.Pointer, swapped in by
+// NewWatching's background reload goroutine. A Validate call in flight when
+// a swap happens keeps running against whichever *validator it already
+// loaded, so reloading never interrupts or corrupts an in-progress
+// validation.
+type WatchingValidator struct {
+	current atomic.Pointer[validator]
+}
+
+// Validate runs against whichever config is current at the time of the call.
+func (w *WatchingValidator) Validate(data any) error {
+	return w.current.Load().Validate(data)
+}
+
+// ValidateContext runs against whichever config is current at the time of
+// the call.
+func (w *WatchingValidator) ValidateContext(ctx context.Context, data any) error {
+	return w.current.Load().ValidateContext(ctx, data)
+}
+
+// NewWatching builds a validator from loader's current config, then starts a
+// background goroutine that reloads and atomically swaps in a fresh one
+// whenever loader's file changes on disk. The returned func stops the
+// watcher; callers should invoke it once the validator is no longer needed
+// to avoid leaking the goroutine.
+func NewWatching(loader *WatchingConfigLoader, opts ...WatchOption) (*WatchingValidator, func() error, error) {
+	settings := &watchSettings{interval: defaultWatchInterval}
+	for _, opt := range opts {
+		opt(settings)
+	}
+
+	cfg, err := loader.Load()
+	if err != nil {
+		return nil, nil, fmt.Errorf("sval: initial config load: %w", err)
+	}
+
+	v, err := NewValidatorFromConfig(cfg, settings.validatorOpts...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("sval: initial config build: %w", err)
+	}
+
+	w := &WatchingValidator{}
+	w.current.Store(v)
+
+	// If the initial stat fails, fall back to "now" rather than the zero
+	// time: a zero lastMod would make the very next successful modTime()
+	// look newer than lastMod and trigger a spurious reload even though the
+	// file never changed.
+	lastMod, err := loader.modTime()
+	if err != nil {
+		lastMod = time.Now()
+	}
+	currentCfg := cfg
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(settings.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				modTime, err := loader.modTime()
+				if err != nil || !modTime.After(lastMod) {
+					continue
+				}
+				lastMod = modTime
+
+				newCfg, err := loader.Load()
+				if err != nil {
+					if settings.onReload != nil {
+						settings.onReload(currentCfg, newCfg, err)
+					}
+					continue
+				}
+
+				newV, err := NewValidatorFromConfig(newCfg, settings.validatorOpts...)
+				if err != nil {
+					if settings.onReload != nil {
+						settings.onReload(currentCfg, newCfg, err)
+					}
+					continue
+				}
+
+				w.current.Store(newV)
+				if settings.onReload != nil {
+					settings.onReload(currentCfg, newCfg, nil)
+				}
+				currentCfg = newCfg
+			}
+		}
+	}()
+
+	var stopOnce sync.Once
+	cancel := func() error {
+		stopOnce.Do(func() {
+			close(stop)
+			<-done
+		})
+		return nil
+	}
+
+	return w, cancel, nil
+}
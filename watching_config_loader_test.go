@@ -0,0 +1,96 @@
+package sval
+
+import (
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeWatchConfig(t *testing.T, path, minLen string) {
+	t.Helper()
+	assert.NoError(t, os.WriteFile(path, []byte(`
+rules:
+  code:
+    type: string
+    params:
+      min_len: `+minLen+`
+`), 0644))
+}
+
+func TestWatchingConfigLoader_ReloadsOnChange(t *testing.T) {
+	path := "sval_watch_test.yaml"
+	writeWatchConfig(t, path, "2")
+	defer os.Remove(path)
+
+	type TestStruct struct {
+		Code string `sval:"code"`
+	}
+
+	var reloads atomic.Int32
+	v, cancel, err := NewWatching(
+		NewWatchingConfigLoader(&FileConfigLoader{Path: path}),
+		WithWatchInterval(20*time.Millisecond),
+		WithOnReload(func(oldCfg, newCfg ValidatorConfig, err error) { reloads.Add(1) }),
+	)
+	assert.NoError(t, err)
+
+	// min_len: 2, so a 1-char code fails.
+	assert.Error(t, v.Validate(TestStruct{Code: "a"}))
+
+	// Bump min_len to 5. FileConfigLoader's mtime resolution needs the
+	// write to land strictly after the initial load, so give it a moment.
+	time.Sleep(10 * time.Millisecond)
+	writeWatchConfig(t, path, "5")
+
+	assert.Eventually(t, func() bool {
+		return v.Validate(TestStruct{Code: "abcd"}) != nil
+	}, time.Second, 10*time.Millisecond, "expected the reloaded min_len: 5 rule to reject a 4-char code")
+
+	assert.NoError(t, v.Validate(TestStruct{Code: "abcde"}))
+
+	// cancel blocks until the watcher goroutine has fully exited, so reading
+	// reloads afterwards is synchronized with every OnReload call it made.
+	assert.NoError(t, cancel())
+	assert.Greater(t, reloads.Load(), int32(0))
+}
+
+func TestWatchingConfigLoader_BadReloadKeepsPreviousConfig(t *testing.T) {
+	path := "sval_watch_bad_test.yaml"
+	writeWatchConfig(t, path, "2")
+	defer os.Remove(path)
+
+	type TestStruct struct {
+		Code string `sval:"code"`
+	}
+
+	var sawReloadErr atomic.Bool
+	v, cancel, err := NewWatching(
+		NewWatchingConfigLoader(&FileConfigLoader{Path: path}),
+		WithWatchInterval(20*time.Millisecond),
+		WithOnReload(func(oldCfg, newCfg ValidatorConfig, err error) {
+			if err != nil {
+				sawReloadErr.Store(true)
+			}
+		}),
+	)
+	assert.NoError(t, err)
+	defer cancel()
+
+	time.Sleep(10 * time.Millisecond)
+	assert.NoError(t, os.WriteFile(path, []byte("not: [valid yaml"), 0644))
+
+	assert.Eventually(t, func() bool {
+		return sawReloadErr.Load()
+	}, time.Second, 10*time.Millisecond, "expected the bad edit to be reported via OnReload")
+
+	// The previous, valid config is still in effect.
+	assert.Error(t, v.Validate(TestStruct{Code: "a"}))
+}
+
+func TestNewWatching_InitialLoadErrorSurfaces(t *testing.T) {
+	_, _, err := NewWatching(NewWatchingConfigLoader(&FileConfigLoader{Path: "sval_watch_missing_test.yaml"}))
+	assert.Error(t, err)
+}